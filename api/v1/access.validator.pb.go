@@ -0,0 +1,93 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1/access.proto
+
+package profile_v1
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/mwitkow/go-proto-validators"
+	regexp "regexp"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+var _regex_Policy_Subject = regexp.MustCompile(`^[^,"\x00-\x1f\x7f]+$`)
+var _regex_Policy_Object = regexp.MustCompile(`^[^,"\x00-\x1f\x7f]+$`)
+var _regex_Policy_Action = regexp.MustCompile(`^[^,"\x00-\x1f\x7f]+$`)
+
+func (this *Policy) Validate() error {
+	if !_regex_Policy_Subject.MatchString(this.Subject) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Subject", fmt.Errorf(`must not be empty or contain commas, quotes or control characters such as line breaks`))
+	}
+	if !_regex_Policy_Object.MatchString(this.Object) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf(`must not be empty or contain commas, quotes or control characters such as line breaks`))
+	}
+	if !_regex_Policy_Action.MatchString(this.Action) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Action", fmt.Errorf(`must not be empty or contain commas, quotes or control characters such as line breaks`))
+	}
+	return nil
+}
+
+var _regex_RoleAssignment_Subject = regexp.MustCompile(`^[^,"\x00-\x1f\x7f]+$`)
+var _regex_RoleAssignment_Role = regexp.MustCompile(`^[^,"\x00-\x1f\x7f]+$`)
+
+func (this *RoleAssignment) Validate() error {
+	if !_regex_RoleAssignment_Subject.MatchString(this.Subject) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Subject", fmt.Errorf(`must not be empty or contain commas, quotes or control characters such as line breaks`))
+	}
+	if !_regex_RoleAssignment_Role.MatchString(this.Role) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Role", fmt.Errorf(`must not be empty or contain commas, quotes or control characters such as line breaks`))
+	}
+	return nil
+}
+func (this *ListPoliciesReq) Validate() error {
+	return nil
+}
+func (this *ListPoliciesRes) Validate() error {
+	for _, item := range this.Policies {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Policies", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListRoleAssignmentsReq) Validate() error {
+	return nil
+}
+func (this *ListRoleAssignmentsRes) Validate() error {
+	for _, item := range this.Assignments {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Assignments", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ChangePolicyRes) Validate() error {
+	return nil
+}
+func (this *CheckAccessReq) Validate() error {
+	if this.Subject == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Subject", fmt.Errorf(`value '%v' must not be an empty string`, this.Subject))
+	}
+	if this.Object == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf(`value '%v' must not be an empty string`, this.Object))
+	}
+	if this.Action == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Action", fmt.Errorf(`value '%v' must not be an empty string`, this.Action))
+	}
+	return nil
+}
+func (this *CheckAccessRes) Validate() error {
+	return nil
+}
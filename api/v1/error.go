@@ -2,27 +2,330 @@ package profile_v1
 
 import (
 	"fmt"
-	"google.golang.org/grpc/codes"
+	"strconv"
+	"strings"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
 )
 
-type ErrProfileNotFound struct {
-	Id string
+// ErrorDomain is the domain of the google.rpc.ErrorInfo detail attached to the errors below.
+const ErrorDomain = "profile.v1"
+
+// The reasons of the google.rpc.ErrorInfo detail attached to the errors below. Reasons are stable,
+// so clients can rely on them to tell errors apart.
+const (
+	ReasonProfileNotFound   = "PROFILE_NOT_FOUND"
+	ReasonProfileExists     = "PROFILE_ALREADY_EXISTS"
+	ReasonProfileDeleted    = "PROFILE_DELETED"
+	ReasonProfileNotDeleted = "PROFILE_NOT_DELETED"
+	ReasonProfileMerged     = "PROFILE_MERGED"
+	ReasonDuplicateProfile  = "DUPLICATE_PROFILE"
+	ReasonRevisionConflict  = "REVISION_CONFLICT"
+	ReasonValidation        = "VALIDATION_FAILED"
+	ReasonPermissionDenied  = "PERMISSION_DENIED"
+	ReasonQuotaExceeded     = "QUOTA_EXCEEDED"
+)
+
+// Error is implemented by the errors of the profile service. Their status carries a
+// google.rpc.ErrorInfo detail describing the error along with a google.rpc.LocalizedMessage.
+type Error interface {
+	error
+	GRPCStatus() *status.Status
+	// LocalizedStatus returns the status with its google.rpc.LocalizedMessage in the locale best
+	// matching an accept-language header, falling back to en-US.
+	LocalizedStatus(acceptLanguage string) *status.Status
 }
 
-func (error ErrProfileNotFound) GRPCStatus() *status.Status {
-	errStatus := status.New(codes.NotFound, fmt.Sprintf("%s not found", error.Id))
-	errMsg := fmt.Sprintf("The requested profile does not exist: %s", error.Id)
-	errDetails := &errdetails.LocalizedMessage{Locale: "en-US", Message: errMsg}
-	errStatusDetails, err := errStatus.WithDetails(errDetails)
+// Localize localizes err for the accept-language header if it is an Error, and otherwise returns it
+// as is.
+func Localize(err error, acceptLanguage string) error {
+	if e, ok := err.(Error); ok {
+		return e.LocalizedStatus(acceptLanguage).Err()
+	}
+	return err
+}
+
+// FromError decodes an error returned by the profile service back into the Error it was sent as.
+// It reports false for errors that aren't one of the profile service's.
+func FromError(err error) (Error, bool) {
+	if e, ok := err.(Error); ok {
+		return e, true
+	}
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return nil, false
+	}
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = detail
+		case *errdetails.BadRequest:
+			badRequest = detail
+		}
+	}
+	if info.GetDomain() != ErrorDomain {
+		return nil, false
+	}
+
+	md := info.GetMetadata()
+	uint64Of := func(key string) uint64 {
+		n, _ := strconv.ParseUint(md[key], 10, 64)
+		return n
+	}
+	switch info.GetReason() {
+	case ReasonProfileNotFound:
+		return ErrProfileNotFound{Id: md["id"]}, true
+	case ReasonProfileExists:
+		return ErrProfileExists{Id: md["id"]}, true
+	case ReasonProfileDeleted:
+		return ErrProfileDeleted{Id: md["id"]}, true
+	case ReasonProfileNotDeleted:
+		return ErrProfileNotDeleted{Id: md["id"]}, true
+	case ReasonProfileMerged:
+		return ErrProfileMerged{Id: md["id"], MergedInto: md["merged_into"]}, true
+	case ReasonDuplicateProfile:
+		return ErrDuplicateProfile{Name: md["name"], DuplicateIds: strings.Split(md["duplicate_ids"], ",")}, true
+	case ReasonRevisionConflict:
+		return ErrRevisionConflict{
+			Id:       md["id"],
+			Expected: uint64Of("expected_revision"),
+			Current:  uint64Of("current_revision"),
+		}, true
+	case ReasonValidation:
+		return ErrValidation{Violations: badRequest.GetFieldViolations()}, true
+	case ReasonPermissionDenied:
+		return ErrPermissionDenied{Subject: md["subject"], Object: md["object"], Action: md["action"]}, true
+	case ReasonQuotaExceeded:
+		return ErrQuotaExceeded{Quota: md["quota"], Limit: uint64Of("limit")}, true
+	}
+	return nil, false
+}
+
+// newStatus returns a status with an ErrorInfo detail for the reason and metadata, followed by any
+// other details and a LocalizedMessage rendered from the metadata.
+func newStatus(code codes.Code, msg, reason string, metadata map[string]string, acceptLanguage string, details ...protoiface.MessageV1) *status.Status {
+	errStatus := status.New(code, msg)
+	locale, errMsg := localize(reason, metadata, acceptLanguage)
+	details = append([]protoiface.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain, Metadata: metadata}}, details...)
+	details = append(details, &errdetails.LocalizedMessage{Locale: locale, Message: errMsg})
+	errStatusDetails, err := errStatus.WithDetails(details...)
 	if err != nil {
 		return errStatus
 	}
 	return errStatusDetails
 }
 
+type ErrProfileNotFound struct {
+	Id string
+}
+
+func (error ErrProfileNotFound) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrProfileNotFound) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.NotFound, fmt.Sprintf("%s not found", error.Id),
+		ReasonProfileNotFound, map[string]string{"id": error.Id}, acceptLanguage)
+}
+
 func (error ErrProfileNotFound) Error() string {
 	return error.GRPCStatus().Err().Error()
 }
+
+// ErrProfileExists is returned when creating a profile with the id of one that already exists.
+type ErrProfileExists struct {
+	Id string
+}
+
+func (error ErrProfileExists) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrProfileExists) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.AlreadyExists, fmt.Sprintf("%s already exists", error.Id),
+		ReasonProfileExists, map[string]string{"id": error.Id}, acceptLanguage)
+}
+
+func (error ErrProfileExists) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrProfileDeleted is returned when changing a profile that is soft deleted in a way that requires
+// it to be live.
+type ErrProfileDeleted struct {
+	Id string
+}
+
+func (error ErrProfileDeleted) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrProfileDeleted) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.FailedPrecondition, fmt.Sprintf("%s is deleted, undelete or purge it first", error.Id),
+		ReasonProfileDeleted, map[string]string{"id": error.Id}, acceptLanguage)
+}
+
+func (error ErrProfileDeleted) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrProfileNotDeleted is returned when undeleting a profile that isn't soft deleted.
+type ErrProfileNotDeleted struct {
+	Id string
+}
+
+func (error ErrProfileNotDeleted) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrProfileNotDeleted) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.FailedPrecondition, fmt.Sprintf("%s is not deleted", error.Id),
+		ReasonProfileNotDeleted, map[string]string{"id": error.Id}, acceptLanguage)
+}
+
+func (error ErrProfileNotDeleted) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrProfileMerged is returned when undeleting a profile that was merged into another.
+type ErrProfileMerged struct {
+	Id         string
+	MergedInto string
+}
+
+func (error ErrProfileMerged) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrProfileMerged) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.FailedPrecondition, fmt.Sprintf("%s was merged into %s", error.Id, error.MergedInto),
+		ReasonProfileMerged, map[string]string{"id": error.Id, "merged_into": error.MergedInto}, acceptLanguage)
+}
+
+func (error ErrProfileMerged) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrDuplicateProfile is returned when creating a profile with the same normalized name as existing
+// profiles while duplicates are rejected.
+type ErrDuplicateProfile struct {
+	// Name is the normalized name the profiles share.
+	Name         string
+	DuplicateIds []string
+}
+
+func (error ErrDuplicateProfile) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrDuplicateProfile) LocalizedStatus(acceptLanguage string) *status.Status {
+	ids := strings.Join(error.DuplicateIds, ",")
+	return newStatus(codes.AlreadyExists, fmt.Sprintf("%s duplicates %s", error.Name, ids),
+		ReasonDuplicateProfile, map[string]string{"name": error.Name, "duplicate_ids": ids}, acceptLanguage)
+}
+
+func (error ErrDuplicateProfile) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrRevisionConflict is returned when a change is conditional on an expected revision that is no
+// longer the profile's current revision.
+type ErrRevisionConflict struct {
+	Id       string
+	Expected uint64
+	Current  uint64
+}
+
+func (error ErrRevisionConflict) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrRevisionConflict) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.Aborted, fmt.Sprintf("%s revision conflict", error.Id),
+		ReasonRevisionConflict, map[string]string{
+			"id":                error.Id,
+			"expected_revision": strconv.FormatUint(error.Expected, 10),
+			"current_revision":  strconv.FormatUint(error.Current, 10),
+		}, acceptLanguage)
+}
+
+func (error ErrRevisionConflict) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrValidation is returned for a request with invalid fields, with a violation for each of them.
+type ErrValidation struct {
+	Violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (error ErrValidation) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrValidation) LocalizedStatus(acceptLanguage string) *status.Status {
+	descriptions := make([]string, len(error.Violations))
+	for i, violation := range error.Violations {
+		descriptions[i] = fmt.Sprintf("invalid field %s: %s", violation.Field, violation.Description)
+	}
+	return newStatus(codes.InvalidArgument, strings.Join(descriptions, "; "),
+		ReasonValidation, map[string]string{"violation_count": strconv.Itoa(len(error.Violations))}, acceptLanguage,
+		&errdetails.BadRequest{FieldViolations: error.Violations})
+}
+
+func (error ErrValidation) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrPermissionDenied is returned when a subject isn't permitted to run an action on an object.
+type ErrPermissionDenied struct {
+	Subject string
+	Object  string
+	Action  string
+}
+
+func (error ErrPermissionDenied) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrPermissionDenied) LocalizedStatus(acceptLanguage string) *status.Status {
+	return newStatus(codes.PermissionDenied, fmt.Sprintf("%s not permitted to %s to %s", error.Subject, error.Action, error.Object),
+		ReasonPermissionDenied, map[string]string{
+			"subject": error.Subject,
+			"object":  error.Object,
+			"action":  error.Action,
+		}, acceptLanguage)
+}
+
+func (error ErrPermissionDenied) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
+
+// ErrQuotaExceeded is returned when a request would exceed a limit on the use of the service.
+type ErrQuotaExceeded struct {
+	// Quota names the quota exceeded, such as batch_size.
+	Quota string
+	Limit uint64
+}
+
+func (error ErrQuotaExceeded) GRPCStatus() *status.Status {
+	return error.LocalizedStatus("")
+}
+
+func (error ErrQuotaExceeded) LocalizedStatus(acceptLanguage string) *status.Status {
+	limit := strconv.FormatUint(error.Limit, 10)
+	return newStatus(codes.ResourceExhausted, fmt.Sprintf("%s quota of %s exceeded", error.Quota, limit),
+		ReasonQuotaExceeded, map[string]string{"quota": error.Quota, "limit": limit}, acceptLanguage,
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     error.Quota,
+			Description: fmt.Sprintf("limited to %s", limit),
+		}}})
+}
+
+func (error ErrQuotaExceeded) Error() string {
+	return error.GRPCStatus().Err().Error()
+}
@@ -0,0 +1,69 @@
+package profile_v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+func TestFromError(t *testing.T) {
+	errs := []Error{
+		ErrProfileNotFound{Id: "1"},
+		ErrProfileExists{Id: "1"},
+		ErrProfileDeleted{Id: "1"},
+		ErrProfileNotDeleted{Id: "1"},
+		ErrProfileMerged{Id: "1", MergedInto: "2"},
+		ErrDuplicateProfile{Name: "foo bar", DuplicateIds: []string{"1", "2"}},
+		ErrRevisionConflict{Id: "1", Expected: 2, Current: 3},
+		ErrValidation{Violations: []*errdetails.BadRequest_FieldViolation{{Field: "first_name", Description: "must not be empty"}}},
+		ErrPermissionDenied{Subject: "nobody", Object: "*", Action: "read"},
+		ErrQuotaExceeded{Quota: "batch_size", Limit: 1000},
+	}
+	for _, err := range errs {
+		// Errors received by clients are plain statuses.
+		decoded, ok := FromError(status.Convert(err).Err())
+		require.True(t, ok, err.Error())
+		if v, ok := err.(ErrValidation); ok {
+			assert.Equal(t, v.Violations[0].Field, decoded.(ErrValidation).Violations[0].Field)
+			continue
+		}
+		assert.Equal(t, err, decoded)
+	}
+
+	_, ok := FromError(status.Error(3, "invalid"))
+	assert.False(t, ok)
+}
+
+func TestLocalize(t *testing.T) {
+	messageOf := func(err error) *errdetails.LocalizedMessage {
+		for _, detail := range status.Convert(err).Details() {
+			if msg, ok := detail.(*errdetails.LocalizedMessage); ok {
+				return msg
+			}
+		}
+		return nil
+	}
+
+	err := ErrProfileNotFound{Id: "1"}
+	assert.Equal(t, "en-US", messageOf(err).Locale)
+	assert.Equal(t, "The requested profile does not exist: 1", messageOf(err).Message)
+
+	localized := Localize(err, "fr-CH, fr;q=0.9, en;q=0.8")
+	assert.Equal(t, "fr", messageOf(localized).Locale)
+	assert.Equal(t, "Le profil demandé n'existe pas : 1", messageOf(localized).Message)
+	// Localizing keeps the error's code and reason.
+	assert.Equal(t, status.Code(err), status.Code(localized))
+	decoded, ok := FromError(localized)
+	assert.True(t, ok)
+	assert.Equal(t, err, decoded)
+
+	conflict := Localize(ErrRevisionConflict{Id: "1", Expected: 2, Current: 3}, "de-AT")
+	assert.Equal(t, "Das Profil 1 wurde seit Revision 2 geändert, die aktuelle Revision ist 3", messageOf(conflict).Message)
+
+	// Unsupported and malformed headers fall back to en-US.
+	assert.Equal(t, "en-US", messageOf(Localize(err, "ja")).Locale)
+	assert.Equal(t, "en-US", messageOf(Localize(err, ";;")).Locale)
+}
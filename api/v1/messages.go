@@ -0,0 +1,91 @@
+package profile_v1
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// AcceptLanguageHeader is the metadata header clients set to choose the locale of error messages.
+const AcceptLanguageHeader = "accept-language"
+
+// The locales error messages are available in. The first is the fallback for clients that accept
+// none of them.
+var locales = []language.Tag{
+	language.AmericanEnglish,
+	language.Spanish,
+	language.French,
+	language.German,
+}
+
+var localeMatcher = language.NewMatcher(locales)
+
+// messageBundles holds the localized message for each error reason, keyed by locale. Messages refer
+// to the error's ErrorInfo metadata by key, in braces.
+var messageBundles = map[language.Tag]map[string]string{
+	language.AmericanEnglish: {
+		ReasonProfileNotFound:   "The requested profile does not exist: {id}",
+		ReasonProfileExists:     "A profile already exists with the id: {id}",
+		ReasonProfileDeleted:    "The profile {id} is deleted, undelete or purge it first",
+		ReasonProfileNotDeleted: "The profile {id} is not deleted",
+		ReasonProfileMerged:     "The profile {id} was merged into {merged_into}",
+		ReasonDuplicateProfile:  "A profile named {name} already exists: {duplicate_ids}",
+		ReasonRevisionConflict:  "The profile {id} has been changed since revision {expected_revision}, its current revision is {current_revision}",
+		ReasonValidation:        "The request has {violation_count} invalid field(s)",
+		ReasonPermissionDenied:  "{subject} is not permitted to {action} {object}",
+		ReasonQuotaExceeded:     "The {quota} quota of {limit} has been exceeded",
+	},
+	language.Spanish: {
+		ReasonProfileNotFound:   "El perfil solicitado no existe: {id}",
+		ReasonProfileExists:     "Ya existe un perfil con el id: {id}",
+		ReasonProfileDeleted:    "El perfil {id} está eliminado, restáurelo o púrguelo primero",
+		ReasonProfileNotDeleted: "El perfil {id} no está eliminado",
+		ReasonProfileMerged:     "El perfil {id} se fusionó con {merged_into}",
+		ReasonDuplicateProfile:  "Ya existe un perfil llamado {name}: {duplicate_ids}",
+		ReasonRevisionConflict:  "El perfil {id} ha cambiado desde la revisión {expected_revision}, su revisión actual es {current_revision}",
+		ReasonValidation:        "La solicitud tiene {violation_count} campo(s) no válido(s)",
+		ReasonPermissionDenied:  "{subject} no tiene permiso para la acción {action} sobre {object}",
+		ReasonQuotaExceeded:     "Se ha superado la cuota {quota} de {limit}",
+	},
+	language.French: {
+		ReasonProfileNotFound:   "Le profil demandé n'existe pas : {id}",
+		ReasonProfileExists:     "Un profil existe déjà avec l'identifiant : {id}",
+		ReasonProfileDeleted:    "Le profil {id} est supprimé, restaurez-le ou purgez-le d'abord",
+		ReasonProfileNotDeleted: "Le profil {id} n'est pas supprimé",
+		ReasonProfileMerged:     "Le profil {id} a été fusionné avec {merged_into}",
+		ReasonDuplicateProfile:  "Un profil nommé {name} existe déjà : {duplicate_ids}",
+		ReasonRevisionConflict:  "Le profil {id} a été modifié depuis la révision {expected_revision}, sa révision actuelle est {current_revision}",
+		ReasonValidation:        "La requête contient {violation_count} champ(s) non valide(s)",
+		ReasonPermissionDenied:  "{subject} n'est pas autorisé à effectuer l'action {action} sur {object}",
+		ReasonQuotaExceeded:     "Le quota {quota} de {limit} a été dépassé",
+	},
+	language.German: {
+		ReasonProfileNotFound:   "Das angeforderte Profil existiert nicht: {id}",
+		ReasonProfileExists:     "Es existiert bereits ein Profil mit der ID: {id}",
+		ReasonProfileDeleted:    "Das Profil {id} ist gelöscht, stellen Sie es zuerst wieder her oder löschen Sie es endgültig",
+		ReasonProfileNotDeleted: "Das Profil {id} ist nicht gelöscht",
+		ReasonProfileMerged:     "Das Profil {id} wurde mit {merged_into} zusammengeführt",
+		ReasonDuplicateProfile:  "Es existiert bereits ein Profil mit dem Namen {name}: {duplicate_ids}",
+		ReasonRevisionConflict:  "Das Profil {id} wurde seit Revision {expected_revision} geändert, die aktuelle Revision ist {current_revision}",
+		ReasonValidation:        "Die Anfrage enthält {violation_count} ungültige(s) Feld(er)",
+		ReasonPermissionDenied:  "{subject} ist nicht berechtigt, die Aktion {action} auf {object} auszuführen",
+		ReasonQuotaExceeded:     "Das Kontingent {quota} von {limit} wurde überschritten",
+	},
+}
+
+// localize renders the message for the reason in the locale best matching the accept-language
+// header, returning the locale along with the message.
+func localize(reason string, metadata map[string]string, acceptLanguage string) (string, string) {
+	locale := locales[0]
+	if tags, _, err := language.ParseAcceptLanguage(acceptLanguage); err == nil && len(tags) > 0 {
+		if _, index, confidence := localeMatcher.Match(tags...); confidence != language.No {
+			locale = locales[index]
+		}
+	}
+
+	replacements := make([]string, 0, 2*len(metadata))
+	for key, value := range metadata {
+		replacements = append(replacements, "{"+key+"}", value)
+	}
+	return locale.String(), strings.NewReplacer(replacements...).Replace(messageBundles[locale][reason])
+}
@@ -0,0 +1,13 @@
+package profile_v1
+
+// IsPartial reports whether the request only updates the fields named in its update mask. Partial
+// updates can't be validated as sent, since the fields left out of the mask are expected to be
+// empty, so they're validated once the mask has been applied to the stored profile instead.
+func (m *UpdateProfileReq) IsPartial() bool {
+	return len(m.GetUpdateMask().GetPaths()) > 0
+}
+
+// IsDeleted reports whether the profile has been soft deleted.
+func (m *Profile) IsDeleted() bool {
+	return m.GetDeleteDate() != nil
+}
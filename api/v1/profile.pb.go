@@ -5,15 +5,17 @@ package profile_v1
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	rpc "github.com/gogo/googleapis/google/rpc"
 	_ "github.com/gogo/protobuf/proto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	_ "github.com/mwitkow/go-proto-validators"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -33,15 +35,100 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type BatchMode int32
+
+const (
+	// Treated as ALL_OR_NOTHING.
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// Nothing is applied unless every item succeeds. Items that would have succeeded are reported as
+	// ABORTED.
+	BatchMode_ALL_OR_NOTHING BatchMode = 1
+	// Each item is applied independently of the others.
+	BatchMode_BEST_EFFORT BatchMode = 2
+)
+
+var BatchMode_name = map[int32]string{
+	0: "BATCH_MODE_UNSPECIFIED",
+	1: "ALL_OR_NOTHING",
+	2: "BEST_EFFORT",
+}
+
+var BatchMode_value = map[string]int32{
+	"BATCH_MODE_UNSPECIFIED": 0,
+	"ALL_OR_NOTHING":         1,
+	"BEST_EFFORT":            2,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{0}
+}
+
+type ProfileEvent_Type int32
+
+const (
+	ProfileEvent_TYPE_UNSPECIFIED ProfileEvent_Type = 0
+	ProfileEvent_CREATED          ProfileEvent_Type = 1
+	ProfileEvent_UPDATED          ProfileEvent_Type = 2
+	ProfileEvent_DELETED          ProfileEvent_Type = 3
+)
+
+var ProfileEvent_Type_name = map[int32]string{
+	0: "TYPE_UNSPECIFIED",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+}
+
+var ProfileEvent_Type_value = map[string]int32{
+	"TYPE_UNSPECIFIED": 0,
+	"CREATED":          1,
+	"UPDATED":          2,
+	"DELETED":          3,
+}
+
+func (x ProfileEvent_Type) String() string {
+	return proto.EnumName(ProfileEvent_Type_name, int32(x))
+}
+
+func (ProfileEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{12, 0}
+}
+
 type Profile struct {
-	Id                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName            string     `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string     `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	CreateDate           *time.Time `protobuf:"bytes,4,opt,name=create_date,json=createDate,proto3,stdtime" json:"create_date,omitempty"`
-	UpdateDate           *time.Time `protobuf:"bytes,5,opt,name=update_date,json=updateDate,proto3,stdtime" json:"update_date,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Id         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName  string     `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName   string     `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	CreateDate *time.Time `protobuf:"bytes,4,opt,name=create_date,json=createDate,proto3,stdtime" json:"create_date,omitempty"`
+	UpdateDate *time.Time `protobuf:"bytes,5,opt,name=update_date,json=updateDate,proto3,stdtime" json:"update_date,omitempty"`
+	// Set when the profile has been soft deleted.
+	DeleteDate *time.Time `protobuf:"bytes,6,opt,name=delete_date,json=deleteDate,proto3,stdtime" json:"delete_date,omitempty"`
+	// Revision of the last change to the profile, assigned by the server. Pass it as an
+	// expected_revision to make a change conditional on the profile not having changed since.
+	Revision uint64 `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Subject of the client that made the last change to the profile.
+	UpdatedBy       string           `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	DisplayName     string           `protobuf:"bytes,9,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Emails          []*EmailAddress  `protobuf:"bytes,10,rep,name=emails,proto3" json:"emails,omitempty"`
+	PhoneNumbers    []*PhoneNumber   `protobuf:"bytes,11,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	PostalAddresses []*PostalAddress `protobuf:"bytes,12,rep,name=postal_addresses,json=postalAddresses,proto3" json:"postal_addresses,omitempty"`
+	// Date of birth in the form YYYY-MM-DD.
+	DateOfBirth string `protobuf:"bytes,13,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	// Preferred locale as a BCP 47 language tag, e.g. en-US.
+	Locale string `protobuf:"bytes,14,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Attributes with no field of their own, keyed by name.
+	CustomAttributes map[string]*types.Value `protobuf:"bytes,15,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Set when the profile has been merged into another, to the id of the profile it was merged into.
+	MergedInto string `protobuf:"bytes,16,opt,name=merged_into,json=mergedInto,proto3" json:"merged_into,omitempty"`
+	// Subject of the client that created the profile. Access control policies can permit subjects to
+	// access only the profiles they own.
+	Owner                string   `protobuf:"bytes,17,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Profile) Reset()         { *m = Profile{} }
@@ -112,12 +199,113 @@ func (m *Profile) GetUpdateDate() *time.Time {
 	return nil
 }
 
+func (m *Profile) GetDeleteDate() *time.Time {
+	if m != nil {
+		return m.DeleteDate
+	}
+	return nil
+}
+
+func (m *Profile) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Profile) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *Profile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Profile) GetEmails() []*EmailAddress {
+	if m != nil {
+		return m.Emails
+	}
+	return nil
+}
+
+func (m *Profile) GetPhoneNumbers() []*PhoneNumber {
+	if m != nil {
+		return m.PhoneNumbers
+	}
+	return nil
+}
+
+func (m *Profile) GetPostalAddresses() []*PostalAddress {
+	if m != nil {
+		return m.PostalAddresses
+	}
+	return nil
+}
+
+func (m *Profile) GetDateOfBirth() string {
+	if m != nil {
+		return m.DateOfBirth
+	}
+	return ""
+}
+
+func (m *Profile) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *Profile) GetCustomAttributes() map[string]*types.Value {
+	if m != nil {
+		return m.CustomAttributes
+	}
+	return nil
+}
+
+func (m *Profile) GetMergedInto() string {
+	if m != nil {
+		return m.MergedInto
+	}
+	return ""
+}
+
+func (m *Profile) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 type ProfileDto struct {
-	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Makes retrying CreateProfile safe: a retry with the same key returns the profile created by the
+	// first attempt rather than creating another. May be sent as the idempotency-key metadata header
+	// instead. Ignored by other RPCs.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Id for the profile being created, for clients with identifiers of their own. One is generated
+	// when unset. Ignored by RPCs that don't create profiles.
+	Id              string           `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName     string           `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Emails          []*EmailAddress  `protobuf:"bytes,6,rep,name=emails,proto3" json:"emails,omitempty"`
+	PhoneNumbers    []*PhoneNumber   `protobuf:"bytes,7,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	PostalAddresses []*PostalAddress `protobuf:"bytes,8,rep,name=postal_addresses,json=postalAddresses,proto3" json:"postal_addresses,omitempty"`
+	DateOfBirth     string           `protobuf:"bytes,9,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Locale          string           `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	// At most 50 attributes, named with up to 64 letters, digits, '_' or '-' starting with a letter.
+	// Each must have a value, of at most 4096 bytes encoded, with lists and structs nested at most 5
+	// deep. go-proto-validators can't validate maps, so these limits are checked by hand.
+	CustomAttributes     map[string]*types.Value `protobuf:"bytes,11,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *ProfileDto) Reset()         { *m = ProfileDto{} }
@@ -167,25 +355,90 @@ func (m *ProfileDto) GetLastName() string {
 	return ""
 }
 
-type ReadProfileReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *ProfileDto) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *ProfileDto) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProfileDto) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ProfileDto) GetEmails() []*EmailAddress {
+	if m != nil {
+		return m.Emails
+	}
+	return nil
+}
+
+func (m *ProfileDto) GetPhoneNumbers() []*PhoneNumber {
+	if m != nil {
+		return m.PhoneNumbers
+	}
+	return nil
+}
+
+func (m *ProfileDto) GetPostalAddresses() []*PostalAddress {
+	if m != nil {
+		return m.PostalAddresses
+	}
+	return nil
+}
+
+func (m *ProfileDto) GetDateOfBirth() string {
+	if m != nil {
+		return m.DateOfBirth
+	}
+	return ""
+}
+
+func (m *ProfileDto) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *ProfileDto) GetCustomAttributes() map[string]*types.Value {
+	if m != nil {
+		return m.CustomAttributes
+	}
+	return nil
+}
+
+type EmailAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// What the address is for, e.g. work or home.
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadProfileReq) Reset()         { *m = ReadProfileReq{} }
-func (m *ReadProfileReq) String() string { return proto.CompactTextString(m) }
-func (*ReadProfileReq) ProtoMessage()    {}
-func (*ReadProfileReq) Descriptor() ([]byte, []int) {
+func (m *EmailAddress) Reset()         { *m = EmailAddress{} }
+func (m *EmailAddress) String() string { return proto.CompactTextString(m) }
+func (*EmailAddress) ProtoMessage()    {}
+func (*EmailAddress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20b2777e1b084b3a, []int{2}
 }
-func (m *ReadProfileReq) XXX_Unmarshal(b []byte) error {
+func (m *EmailAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReadProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EmailAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReadProfileReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EmailAddress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -195,45 +448,53 @@ func (m *ReadProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ReadProfileReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadProfileReq.Merge(m, src)
+func (m *EmailAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmailAddress.Merge(m, src)
 }
-func (m *ReadProfileReq) XXX_Size() int {
+func (m *EmailAddress) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReadProfileReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadProfileReq.DiscardUnknown(m)
+func (m *EmailAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmailAddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadProfileReq proto.InternalMessageInfo
+var xxx_messageInfo_EmailAddress proto.InternalMessageInfo
 
-func (m *ReadProfileReq) GetId() string {
+func (m *EmailAddress) GetAddress() string {
 	if m != nil {
-		return m.Id
+		return m.Address
 	}
 	return ""
 }
 
-type UpdateProfileReq struct {
-	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Profile              *ProfileDto `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *EmailAddress) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
 }
 
-func (m *UpdateProfileReq) Reset()         { *m = UpdateProfileReq{} }
-func (m *UpdateProfileReq) String() string { return proto.CompactTextString(m) }
-func (*UpdateProfileReq) ProtoMessage()    {}
-func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+type PhoneNumber struct {
+	// Number in E.164 format, e.g. +61400000000.
+	Number               string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PhoneNumber) Reset()         { *m = PhoneNumber{} }
+func (m *PhoneNumber) String() string { return proto.CompactTextString(m) }
+func (*PhoneNumber) ProtoMessage()    {}
+func (*PhoneNumber) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20b2777e1b084b3a, []int{3}
 }
-func (m *UpdateProfileReq) XXX_Unmarshal(b []byte) error {
+func (m *PhoneNumber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PhoneNumber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateProfileReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PhoneNumber.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -243,51 +504,59 @@ func (m *UpdateProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *UpdateProfileReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateProfileReq.Merge(m, src)
+func (m *PhoneNumber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhoneNumber.Merge(m, src)
 }
-func (m *UpdateProfileReq) XXX_Size() int {
+func (m *PhoneNumber) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateProfileReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateProfileReq.DiscardUnknown(m)
+func (m *PhoneNumber) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhoneNumber.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateProfileReq proto.InternalMessageInfo
+var xxx_messageInfo_PhoneNumber proto.InternalMessageInfo
 
-func (m *UpdateProfileReq) GetId() string {
+func (m *PhoneNumber) GetNumber() string {
 	if m != nil {
-		return m.Id
+		return m.Number
 	}
 	return ""
 }
 
-func (m *UpdateProfileReq) GetProfile() *ProfileDto {
+func (m *PhoneNumber) GetLabel() string {
 	if m != nil {
-		return m.Profile
+		return m.Label
 	}
-	return nil
+	return ""
 }
 
-type DeleteProfileRes struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type PostalAddress struct {
+	AddressLines []string `protobuf:"bytes,1,rep,name=address_lines,json=addressLines,proto3" json:"address_lines,omitempty"`
+	// City or town.
+	Locality string `protobuf:"bytes,2,opt,name=locality,proto3" json:"locality,omitempty"`
+	// State, province or similar.
+	AdministrativeArea string `protobuf:"bytes,3,opt,name=administrative_area,json=administrativeArea,proto3" json:"administrative_area,omitempty"`
+	PostalCode         string `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 country code, e.g. AU.
+	RegionCode           string   `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	Label                string   `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteProfileRes) Reset()         { *m = DeleteProfileRes{} }
-func (m *DeleteProfileRes) String() string { return proto.CompactTextString(m) }
-func (*DeleteProfileRes) ProtoMessage()    {}
-func (*DeleteProfileRes) Descriptor() ([]byte, []int) {
+func (m *PostalAddress) Reset()         { *m = PostalAddress{} }
+func (m *PostalAddress) String() string { return proto.CompactTextString(m) }
+func (*PostalAddress) ProtoMessage()    {}
+func (*PostalAddress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20b2777e1b084b3a, []int{4}
 }
-func (m *DeleteProfileRes) XXX_Unmarshal(b []byte) error {
+func (m *PostalAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteProfileRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PostalAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteProfileRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PostalAddress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -297,44 +566,83 @@ func (m *DeleteProfileRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeleteProfileRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteProfileRes.Merge(m, src)
+func (m *PostalAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostalAddress.Merge(m, src)
 }
-func (m *DeleteProfileRes) XXX_Size() int {
+func (m *PostalAddress) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteProfileRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteProfileRes.DiscardUnknown(m)
+func (m *PostalAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostalAddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteProfileRes proto.InternalMessageInfo
+var xxx_messageInfo_PostalAddress proto.InternalMessageInfo
 
-func (m *DeleteProfileRes) GetSuccess() bool {
+func (m *PostalAddress) GetAddressLines() []string {
 	if m != nil {
-		return m.Success
+		return m.AddressLines
 	}
-	return false
+	return nil
 }
 
-type ListProfilesRes struct {
-	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PostalAddress) GetLocality() string {
+	if m != nil {
+		return m.Locality
+	}
+	return ""
 }
 
-func (m *ListProfilesRes) Reset()         { *m = ListProfilesRes{} }
-func (m *ListProfilesRes) String() string { return proto.CompactTextString(m) }
-func (*ListProfilesRes) ProtoMessage()    {}
-func (*ListProfilesRes) Descriptor() ([]byte, []int) {
+func (m *PostalAddress) GetAdministrativeArea() string {
+	if m != nil {
+		return m.AdministrativeArea
+	}
+	return ""
+}
+
+func (m *PostalAddress) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+func (m *PostalAddress) GetRegionCode() string {
+	if m != nil {
+		return m.RegionCode
+	}
+	return ""
+}
+
+func (m *PostalAddress) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type ReadProfileReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether a soft deleted profile is returned rather than treated as not found.
+	ShowDeleted bool `protobuf:"varint,2,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// When set, the profile is read as it was at this time rather than as it is now.
+	AsOf                 *time.Time `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,stdtime" json:"as_of,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReadProfileReq) Reset()         { *m = ReadProfileReq{} }
+func (m *ReadProfileReq) String() string { return proto.CompactTextString(m) }
+func (*ReadProfileReq) ProtoMessage()    {}
+func (*ReadProfileReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20b2777e1b084b3a, []int{5}
 }
-func (m *ListProfilesRes) XXX_Unmarshal(b []byte) error {
+func (m *ReadProfileReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListProfilesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReadProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListProfilesRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReadProfileReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -344,698 +652,9057 @@ func (m *ListProfilesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ListProfilesRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListProfilesRes.Merge(m, src)
+func (m *ReadProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadProfileReq.Merge(m, src)
 }
-func (m *ListProfilesRes) XXX_Size() int {
+func (m *ReadProfileReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListProfilesRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListProfilesRes.DiscardUnknown(m)
+func (m *ReadProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadProfileReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListProfilesRes proto.InternalMessageInfo
+var xxx_messageInfo_ReadProfileReq proto.InternalMessageInfo
 
-func (m *ListProfilesRes) GetProfile() *Profile {
+func (m *ReadProfileReq) GetId() string {
 	if m != nil {
-		return m.Profile
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*Profile)(nil), "profile.v1.Profile")
-	proto.RegisterType((*ProfileDto)(nil), "profile.v1.ProfileDto")
-	proto.RegisterType((*ReadProfileReq)(nil), "profile.v1.ReadProfileReq")
-	proto.RegisterType((*UpdateProfileReq)(nil), "profile.v1.UpdateProfileReq")
-	proto.RegisterType((*DeleteProfileRes)(nil), "profile.v1.DeleteProfileRes")
-	proto.RegisterType((*ListProfilesRes)(nil), "profile.v1.ListProfilesRes")
+func (m *ReadProfileReq) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("api/v1/profile.proto", fileDescriptor_20b2777e1b084b3a) }
+func (m *ReadProfileReq) GetAsOf() *time.Time {
+	if m != nil {
+		return m.AsOf
+	}
+	return nil
+}
 
-var fileDescriptor_20b2777e1b084b3a = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0x9a, 0x42, 0x9a, 0x49, 0x13, 0xca, 0x52, 0xaa, 0xe0, 0x40, 0x62, 0xf9, 0x54, 0x21,
-	0x62, 0xd3, 0x20, 0x55, 0x9c, 0x50, 0x09, 0xe9, 0x09, 0x84, 0x90, 0x29, 0x12, 0xb7, 0x6a, 0x63,
-	0x6f, 0xcc, 0x0a, 0xbb, 0x6b, 0xbc, 0xeb, 0x54, 0x5c, 0xf8, 0x06, 0x3e, 0x8b, 0x23, 0x77, 0x0e,
-	0x45, 0x39, 0xf3, 0x11, 0xc8, 0x6b, 0x3b, 0xb1, 0x4d, 0x03, 0xbd, 0xed, 0xce, 0xbc, 0x79, 0x33,
-	0xef, 0xcd, 0xc0, 0x1e, 0x89, 0x98, 0xbd, 0x38, 0xb4, 0xa3, 0x98, 0xcf, 0x59, 0x40, 0xad, 0x28,
-	0xe6, 0x92, 0x63, 0x28, 0xbe, 0x8b, 0x43, 0xbd, 0xef, 0x73, 0xee, 0x07, 0xd4, 0x56, 0x99, 0x59,
-	0x32, 0xb7, 0x69, 0x18, 0xc9, 0x2f, 0x19, 0x50, 0x1f, 0xd6, 0x93, 0x92, 0x85, 0x54, 0x48, 0x12,
-	0x46, 0x39, 0x60, 0xcf, 0xe7, 0x3e, 0x57, 0x4f, 0x3b, 0x7d, 0xe5, 0xd1, 0x23, 0x9f, 0xc9, 0x8f,
-	0xc9, 0xcc, 0x72, 0x79, 0x68, 0x87, 0x17, 0x4c, 0x7e, 0xe2, 0x17, 0xb6, 0xcf, 0x47, 0x2a, 0x39,
-	0x5a, 0x90, 0x80, 0x79, 0x44, 0xf2, 0x58, 0xd8, 0xab, 0x67, 0x56, 0x67, 0xfe, 0x44, 0xd0, 0x7c,
-	0x9b, 0x8d, 0x86, 0xbb, 0xa0, 0x31, 0xaf, 0x87, 0x0c, 0x74, 0xd0, 0x72, 0x34, 0xe6, 0xe1, 0x87,
-	0x00, 0x73, 0x16, 0x0b, 0x79, 0x76, 0x4e, 0x42, 0xda, 0xd3, 0x54, 0xbc, 0xa5, 0x22, 0x6f, 0x48,
-	0x48, 0x71, 0x1f, 0x5a, 0x01, 0x29, 0xb2, 0x37, 0x54, 0x76, 0x3b, 0x0d, 0xa8, 0xe4, 0x0b, 0x68,
-	0xbb, 0x31, 0x25, 0x92, 0x9e, 0x79, 0x44, 0xd2, 0xde, 0x96, 0x81, 0x0e, 0xda, 0x63, 0xdd, 0xca,
-	0xc4, 0x59, 0x85, 0x38, 0xeb, 0xb4, 0x10, 0x37, 0xd9, 0xfa, 0x76, 0x39, 0x44, 0x0e, 0x64, 0x45,
-	0x53, 0x22, 0x15, 0x45, 0x12, 0x79, 0x2b, 0x8a, 0x9b, 0xd7, 0xa5, 0xc8, 0x8a, 0x52, 0x0a, 0xf3,
-	0x2b, 0x40, 0x2e, 0x6e, 0x2a, 0x39, 0x7e, 0x56, 0xd1, 0xa3, 0x74, 0x4e, 0xee, 0x2f, 0x2f, 0x87,
-	0xf7, 0x1e, 0xdd, 0x09, 0x13, 0x21, 0x8d, 0x73, 0x2e, 0x8d, 0x19, 0x35, 0xd4, 0x36, 0x3e, 0xa0,
-	0xb2, 0xd4, 0xa3, 0xb2, 0x54, 0xed, 0x7f, 0x85, 0x2b, 0x17, 0x4c, 0x03, 0xba, 0x0e, 0x25, 0x5e,
-	0x3e, 0x83, 0x43, 0x3f, 0xd7, 0x3d, 0x36, 0x4f, 0x61, 0xf7, 0xbd, 0x9a, 0x77, 0x33, 0x06, 0x3f,
-	0x81, 0x66, 0x7e, 0x3d, 0xaa, 0x77, 0x7b, 0xbc, 0x6f, 0xad, 0xaf, 0xc9, 0x5a, 0x0b, 0x74, 0x0a,
-	0x98, 0xf9, 0x18, 0x76, 0xa7, 0x34, 0xa0, 0x25, 0x56, 0x81, 0x7b, 0xd0, 0x14, 0x89, 0xeb, 0x52,
-	0x21, 0x14, 0xf5, 0xb6, 0x53, 0x7c, 0xcd, 0x63, 0xb8, 0xfd, 0x9a, 0x09, 0x99, 0x63, 0x45, 0x0a,
-	0x1e, 0xad, 0x5b, 0x22, 0xd5, 0xf2, 0xee, 0x15, 0x2d, 0x57, 0xfd, 0xc6, 0xbf, 0x35, 0xe8, 0xe6,
-	0xc1, 0x77, 0x34, 0x5e, 0x30, 0x97, 0xe2, 0xe7, 0xd0, 0x79, 0xa9, 0x76, 0x59, 0x5c, 0xd7, 0x86,
-	0xa1, 0xf5, 0xab, 0x98, 0xcd, 0x06, 0x3e, 0x86, 0x76, 0xc9, 0x3a, 0xac, 0x97, 0x51, 0x55, 0x4f,
-	0x37, 0x31, 0x4c, 0xa1, 0x53, 0xb1, 0x16, 0x3f, 0x28, 0xe3, 0xea, 0xae, 0x6f, 0x62, 0x79, 0x05,
-	0x9d, 0x8a, 0x95, 0xff, 0x9c, 0xa4, 0xd2, 0xa1, 0xbe, 0x01, 0xb3, 0x81, 0x4f, 0x60, 0xa7, 0xec,
-	0x34, 0xde, 0xff, 0xeb, 0x9a, 0x4f, 0xd2, 0x1b, 0xd2, 0xfb, 0x65, 0x9e, 0xda, 0x6e, 0xcc, 0xc6,
-	0x64, 0xe7, 0xfb, 0x72, 0x80, 0x7e, 0x2c, 0x07, 0xe8, 0xd7, 0x72, 0x80, 0x66, 0xb7, 0x54, 0xf1,
-	0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0xf5, 0x06, 0x25, 0x79, 0x04, 0x00, 0x00,
+type UpdateProfileReq struct {
+	Id      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Profile *ProfileDto `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Paths of the ProfileDto fields to update, e.g. "first_name", or "custom_attributes.<name>" for a
+	// single custom attribute. All fields are replaced when unset.
+	UpdateMask *types.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set, the update is rejected with ABORTED unless this is the profile's current revision.
+	ExpectedRevision     uint64   `protobuf:"varint,4,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *UpdateProfileReq) Reset()         { *m = UpdateProfileReq{} }
+func (m *UpdateProfileReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileReq) ProtoMessage()    {}
+func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{6}
+}
+func (m *UpdateProfileReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateProfileReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileReq.Merge(m, src)
+}
+func (m *UpdateProfileReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileReq.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_UpdateProfileReq proto.InternalMessageInfo
 
-// ProfileServiceClient is the client API for ProfileService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProfileServiceClient interface {
-	CreateProfile(ctx context.Context, in *ProfileDto, opts ...grpc.CallOption) (*Profile, error)
-	ReadProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*Profile, error)
-	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error)
-	DeleteProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*DeleteProfileRes, error)
-	ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProfilesRes, error)
+func (m *UpdateProfileReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-type profileServiceClient struct {
-	cc *grpc.ClientConn
+func (m *UpdateProfileReq) GetProfile() *ProfileDto {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
 }
 
-func NewProfileServiceClient(cc *grpc.ClientConn) ProfileServiceClient {
-	return &profileServiceClient{cc}
+func (m *UpdateProfileReq) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
 }
 
-func (c *profileServiceClient) CreateProfile(ctx context.Context, in *ProfileDto, opts ...grpc.CallOption) (*Profile, error) {
-	out := new(Profile)
-	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/CreateProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateProfileReq) GetExpectedRevision() uint64 {
+	if m != nil {
+		return m.ExpectedRevision
 	}
-	return out, nil
+	return 0
 }
 
-func (c *profileServiceClient) ReadProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*Profile, error) {
-	out := new(Profile)
-	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/ReadProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DeleteProfileReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// When set, the delete is rejected with ABORTED unless this is the profile's current revision.
+	ExpectedRevision     uint64   `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error) {
-	out := new(Profile)
-	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/UpdateProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteProfileReq) Reset()         { *m = DeleteProfileReq{} }
+func (m *DeleteProfileReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteProfileReq) ProtoMessage()    {}
+func (*DeleteProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{7}
+}
+func (m *DeleteProfileReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteProfileReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProfileReq.Merge(m, src)
+}
+func (m *DeleteProfileReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProfileReq.DiscardUnknown(m)
 }
 
-func (c *profileServiceClient) DeleteProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*DeleteProfileRes, error) {
-	out := new(DeleteProfileRes)
-	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/DeleteProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteProfileReq proto.InternalMessageInfo
+
+func (m *DeleteProfileReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *profileServiceClient) ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProfilesRes, error) {
-	out := new(ListProfilesRes)
-	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/ListProfiles", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteProfileReq) GetExpectedRevision() uint64 {
+	if m != nil {
+		return m.ExpectedRevision
 	}
-	return out, nil
+	return 0
 }
 
-// ProfileServiceServer is the server API for ProfileService service.
-type ProfileServiceServer interface {
-	CreateProfile(context.Context, *ProfileDto) (*Profile, error)
-	ReadProfile(context.Context, *ReadProfileReq) (*Profile, error)
-	UpdateProfile(context.Context, *UpdateProfileReq) (*Profile, error)
-	DeleteProfile(context.Context, *ReadProfileReq) (*DeleteProfileRes, error)
-	ListProfiles(context.Context, *emptypb.Empty) (*ListProfilesRes, error)
+type DeleteProfileRes struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedProfileServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedProfileServiceServer struct {
+func (m *DeleteProfileRes) Reset()         { *m = DeleteProfileRes{} }
+func (m *DeleteProfileRes) String() string { return proto.CompactTextString(m) }
+func (*DeleteProfileRes) ProtoMessage()    {}
+func (*DeleteProfileRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{8}
 }
-
-func (*UnimplementedProfileServiceServer) CreateProfile(ctx context.Context, req *ProfileDto) (*Profile, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+func (m *DeleteProfileRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedProfileServiceServer) ReadProfile(ctx context.Context, req *ReadProfileReq) (*Profile, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadProfile not implemented")
+func (m *DeleteProfileRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteProfileRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedProfileServiceServer) UpdateProfile(ctx context.Context, req *UpdateProfileReq) (*Profile, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+func (m *DeleteProfileRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProfileRes.Merge(m, src)
 }
-func (*UnimplementedProfileServiceServer) DeleteProfile(ctx context.Context, req *ReadProfileReq) (*DeleteProfileRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+func (m *DeleteProfileRes) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedProfileServiceServer) ListProfiles(ctx context.Context, req *emptypb.Empty) (*ListProfilesRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+func (m *DeleteProfileRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProfileRes.DiscardUnknown(m)
 }
 
-func RegisterProfileServiceServer(s *grpc.Server, srv ProfileServiceServer) {
-	s.RegisterService(&_ProfileService_serviceDesc, srv)
-}
+var xxx_messageInfo_DeleteProfileRes proto.InternalMessageInfo
 
-func _ProfileService_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProfileDto)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/profile.v1.ProfileService/CreateProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateProfile(ctx, req.(*ProfileDto))
+func (m *DeleteProfileRes) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _ProfileService_ReadProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadProfileReq)
-	if err := dec(in); err != nil {
-		return nil, err
+type ListProfilesReq struct {
+	// Maximum number of profiles to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response, to continue listing where it left off.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// One of create_date (the default), update_date or last_name, optionally followed by " desc".
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Boolean expression profiles must satisfy, e.g. last_name == 'Bar' && create_date > '2021-01-01'.
+	// Available fields are id, first_name, last_name, display_name, locale, owner, create_date and
+	// update_date.
+	Filter               string   `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	ShowDeleted          bool     `protobuf:"varint,5,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProfilesReq) Reset()         { *m = ListProfilesReq{} }
+func (m *ListProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*ListProfilesReq) ProtoMessage()    {}
+func (*ListProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{9}
+}
+func (m *ListProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ProfileServiceServer).ReadProfile(ctx, in)
+}
+func (m *ListProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProfilesReq.Merge(m, src)
+}
+func (m *ListProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProfilesReq proto.InternalMessageInfo
+
+func (m *ListProfilesReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/profile.v1.ProfileService/ReadProfile",
+	return 0
+}
+
+func (m *ListProfilesReq) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).ReadProfile(ctx, req.(*ReadProfileReq))
+	return ""
+}
+
+func (m *ListProfilesReq) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProfileReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProfileServiceServer).UpdateProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/profile.v1.ProfileService/UpdateProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+func (m *ListProfilesReq) GetFilter() string {
+	if m != nil {
+		return m.Filter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ProfileService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadProfileReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProfileServiceServer).DeleteProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/profile.v1.ProfileService/DeleteProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).DeleteProfile(ctx, req.(*ReadProfileReq))
+func (m *ListProfilesReq) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _ProfileService_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProfileServiceServer).ListProfiles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/profile.v1.ProfileService/ListProfiles",
+type StreamProfilesReq struct {
+	// As for ListProfilesReq.
+	OrderBy              string   `protobuf:"bytes,1,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter               string   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	ShowDeleted          bool     `protobuf:"varint,3,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamProfilesReq) Reset()         { *m = StreamProfilesReq{} }
+func (m *StreamProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*StreamProfilesReq) ProtoMessage()    {}
+func (*StreamProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{10}
+}
+func (m *StreamProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).ListProfiles(ctx, req.(*emptypb.Empty))
+}
+func (m *StreamProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamProfilesReq.Merge(m, src)
+}
+func (m *StreamProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamProfilesReq proto.InternalMessageInfo
+
+func (m *StreamProfilesReq) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _ProfileService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "profile.v1.ProfileService",
-	HandlerType: (*ProfileServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProfile",
-			Handler:    _ProfileService_CreateProfile_Handler,
-		},
-		{
-			MethodName: "ReadProfile",
-			Handler:    _ProfileService_ReadProfile_Handler,
-		},
-		{
-			MethodName: "UpdateProfile",
-			Handler:    _ProfileService_UpdateProfile_Handler,
-		},
-		{
-			MethodName: "DeleteProfile",
-			Handler:    _ProfileService_DeleteProfile_Handler,
-		},
-		{
-			MethodName: "ListProfiles",
-			Handler:    _ProfileService_ListProfiles_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api/v1/profile.proto",
+func (m *StreamProfilesReq) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
 }
 
-func (m *Profile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamProfilesReq) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WatchProfilesReq struct {
+	// Revision of the last event the client received. Events after it are replayed before new ones
+	// are streamed. Fails with OUT_OF_RANGE if those events are no longer retained by the server.
+	ResumeRevision       uint64   `protobuf:"varint,1,opt,name=resume_revision,json=resumeRevision,proto3" json:"resume_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Profile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UpdateDate != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateDate):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintProfile(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CreateDate != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateDate):])
-		if err2 != nil {
-			return 0, err2
+func (m *WatchProfilesReq) Reset()         { *m = WatchProfilesReq{} }
+func (m *WatchProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*WatchProfilesReq) ProtoMessage()    {}
+func (*WatchProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{11}
+}
+func (m *WatchProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintProfile(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WatchProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchProfilesReq.Merge(m, src)
+}
+func (m *WatchProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchProfilesReq.DiscardUnknown(m)
 }
 
-func (m *ProfileDto) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WatchProfilesReq proto.InternalMessageInfo
+
+func (m *WatchProfilesReq) GetResumeRevision() uint64 {
+	if m != nil {
+		return m.ResumeRevision
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProfileDto) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ProfileEvent struct {
+	Type    ProfileEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=profile.v1.ProfileEvent_Type" json:"type,omitempty"`
+	Profile *Profile          `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Monotonically increasing revision of the change. Revisions are not necessarily contiguous.
+	Revision             uint64   `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProfileDto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0xa
+func (m *ProfileEvent) Reset()         { *m = ProfileEvent{} }
+func (m *ProfileEvent) String() string { return proto.CompactTextString(m) }
+func (*ProfileEvent) ProtoMessage()    {}
+func (*ProfileEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{12}
+}
+func (m *ProfileEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProfileEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileEvent.Merge(m, src)
+}
+func (m *ProfileEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileEvent.DiscardUnknown(m)
 }
 
-func (m *ReadProfileReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProfileEvent proto.InternalMessageInfo
+
+func (m *ProfileEvent) GetType() ProfileEvent_Type {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ProfileEvent_TYPE_UNSPECIFIED
 }
 
-func (m *ReadProfileReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProfileEvent) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
 }
 
-func (m *ReadProfileReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *ProfileEvent) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UpdateProfileReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ListProfilesRes struct {
+	Profiles []*Profile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	// Token for the next page, or empty when there are no more profiles.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of profiles matching the filter across all pages.
+	TotalSize            int32    `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateProfileReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListProfilesRes) Reset()         { *m = ListProfilesRes{} }
+func (m *ListProfilesRes) String() string { return proto.CompactTextString(m) }
+func (*ListProfilesRes) ProtoMessage()    {}
+func (*ListProfilesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{13}
 }
-
-func (m *UpdateProfileReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Profile != nil {
-		{
-			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProfile(dAtA, i, uint64(size))
+func (m *ListProfilesRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListProfilesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListProfilesRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DeleteProfileRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListProfilesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProfilesRes.Merge(m, src)
 }
-
-func (m *DeleteProfileRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListProfilesRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListProfilesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProfilesRes.DiscardUnknown(m)
 }
 
-func (m *DeleteProfileRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ListProfilesRes proto.InternalMessageInfo
+
+func (m *ListProfilesRes) GetProfiles() []*Profile {
+	if m != nil {
+		return m.Profiles
 	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *ListProfilesRes) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListProfilesRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListProfilesRes) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListProfilesRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListProfileVersionsReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of versions to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response, to continue listing where it left off.
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListProfilesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Profile != nil {
-		{
-			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProfile(dAtA, i, uint64(size))
+func (m *ListProfileVersionsReq) Reset()         { *m = ListProfileVersionsReq{} }
+func (m *ListProfileVersionsReq) String() string { return proto.CompactTextString(m) }
+func (*ListProfileVersionsReq) ProtoMessage()    {}
+func (*ListProfileVersionsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{14}
+}
+func (m *ListProfileVersionsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListProfileVersionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListProfileVersionsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListProfileVersionsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProfileVersionsReq.Merge(m, src)
+}
+func (m *ListProfileVersionsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListProfileVersionsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProfileVersionsReq.DiscardUnknown(m)
 }
 
-func encodeVarintProfile(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProfile(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ListProfileVersionsReq proto.InternalMessageInfo
+
+func (m *ListProfileVersionsReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Profile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	if m.CreateDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateDate)
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	if m.UpdateDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateDate)
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *ListProfileVersionsReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return n
+	return 0
 }
 
-func (m *ProfileDto) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListProfileVersionsReq) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
 	}
-	return n
+	return ""
 }
 
-func (m *ReadProfileReq) Size() (n int) {
-	if m == nil {
-		return 0
+type ListProfileVersionsRes struct {
+	// Versions of the profile, newest first. Each is the profile as it was after one change.
+	Versions []*Profile `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// Token for the next page, or empty when there are no more versions.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProfileVersionsRes) Reset()         { *m = ListProfileVersionsRes{} }
+func (m *ListProfileVersionsRes) String() string { return proto.CompactTextString(m) }
+func (*ListProfileVersionsRes) ProtoMessage()    {}
+func (*ListProfileVersionsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{15}
+}
+func (m *ListProfileVersionsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListProfileVersionsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListProfileVersionsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
+}
+func (m *ListProfileVersionsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProfileVersionsRes.Merge(m, src)
+}
+func (m *ListProfileVersionsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListProfileVersionsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProfileVersionsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProfileVersionsRes proto.InternalMessageInfo
+
+func (m *ListProfileVersionsRes) GetVersions() []*Profile {
+	if m != nil {
+		return m.Versions
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *ListProfileVersionsRes) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return n
+	return ""
 }
 
-func (m *UpdateProfileReq) Size() (n int) {
-	if m == nil {
-		return 0
+type BatchCreateProfilesReq struct {
+	Profiles             []*ProfileDto `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Mode                 BatchMode     `protobuf:"varint,2,opt,name=mode,proto3,enum=profile.v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BatchCreateProfilesReq) Reset()         { *m = BatchCreateProfilesReq{} }
+func (m *BatchCreateProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateProfilesReq) ProtoMessage()    {}
+func (*BatchCreateProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{16}
+}
+func (m *BatchCreateProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCreateProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovProfile(uint64(l))
+}
+func (m *BatchCreateProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateProfilesReq.Merge(m, src)
+}
+func (m *BatchCreateProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCreateProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateProfilesReq proto.InternalMessageInfo
+
+func (m *BatchCreateProfilesReq) GetProfiles() []*ProfileDto {
+	if m != nil {
+		return m.Profiles
 	}
-	if m.Profile != nil {
-		l = m.Profile.Size()
-		n += 1 + l + sovProfile(uint64(l))
+	return nil
+}
+
+func (m *BatchCreateProfilesReq) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type BatchUpdateProfilesReq struct {
+	Requests             []*UpdateProfileReq `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Mode                 BatchMode           `protobuf:"varint,2,opt,name=mode,proto3,enum=profile.v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BatchUpdateProfilesReq) Reset()         { *m = BatchUpdateProfilesReq{} }
+func (m *BatchUpdateProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateProfilesReq) ProtoMessage()    {}
+func (*BatchUpdateProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{17}
+}
+func (m *BatchUpdateProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchUpdateProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchUpdateProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BatchUpdateProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateProfilesReq.Merge(m, src)
+}
+func (m *BatchUpdateProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchUpdateProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateProfilesReq.DiscardUnknown(m)
 }
 
-func (m *DeleteProfileRes) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BatchUpdateProfilesReq proto.InternalMessageInfo
+
+func (m *BatchUpdateProfilesReq) GetRequests() []*UpdateProfileReq {
+	if m != nil {
+		return m.Requests
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	return nil
+}
+
+func (m *BatchUpdateProfilesReq) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type BatchDeleteProfilesReq struct {
+	Requests             []*DeleteProfileReq `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Mode                 BatchMode           `protobuf:"varint,2,opt,name=mode,proto3,enum=profile.v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BatchDeleteProfilesReq) Reset()         { *m = BatchDeleteProfilesReq{} }
+func (m *BatchDeleteProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteProfilesReq) ProtoMessage()    {}
+func (*BatchDeleteProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{18}
+}
+func (m *BatchDeleteProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchDeleteProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchDeleteProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchDeleteProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteProfilesReq.Merge(m, src)
+}
+func (m *BatchDeleteProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchDeleteProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteProfilesReq proto.InternalMessageInfo
+
+func (m *BatchDeleteProfilesReq) GetRequests() []*DeleteProfileReq {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *BatchDeleteProfilesReq) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type BatchProfilesRes struct {
+	Results              []*BatchProfileResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BatchProfilesRes) Reset()         { *m = BatchProfilesRes{} }
+func (m *BatchProfilesRes) String() string { return proto.CompactTextString(m) }
+func (*BatchProfilesRes) ProtoMessage()    {}
+func (*BatchProfilesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{19}
+}
+func (m *BatchProfilesRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchProfilesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchProfilesRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchProfilesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchProfilesRes.Merge(m, src)
+}
+func (m *BatchProfilesRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchProfilesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchProfilesRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchProfilesRes proto.InternalMessageInfo
+
+func (m *BatchProfilesRes) GetResults() []*BatchProfileResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type BatchProfileResult struct {
+	// OK when the item was applied, otherwise why it wasn't.
+	Status *rpc.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The created, updated or deleted profile when the item was applied.
+	Profile              *Profile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchProfileResult) Reset()         { *m = BatchProfileResult{} }
+func (m *BatchProfileResult) String() string { return proto.CompactTextString(m) }
+func (*BatchProfileResult) ProtoMessage()    {}
+func (*BatchProfileResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{20}
+}
+func (m *BatchProfileResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchProfileResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchProfileResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchProfileResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchProfileResult.Merge(m, src)
+}
+func (m *BatchProfileResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchProfileResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchProfileResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchProfileResult proto.InternalMessageInfo
+
+func (m *BatchProfileResult) GetStatus() *rpc.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *BatchProfileResult) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type ImportProfilesReq struct {
+	// Identifies the import for resuming it. Only read from the first message of a stream.
+	ImportId string `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	// Position of the record in the import, starting from 0. Records before the import's
+	// next_sequence have already been imported and are skipped.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The record to import. A message without one just requests progress, for example to learn where
+	// to resume from.
+	Profile              *ProfileDto `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ImportProfilesReq) Reset()         { *m = ImportProfilesReq{} }
+func (m *ImportProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*ImportProfilesReq) ProtoMessage()    {}
+func (*ImportProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{21}
+}
+func (m *ImportProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportProfilesReq.Merge(m, src)
+}
+func (m *ImportProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportProfilesReq proto.InternalMessageInfo
+
+func (m *ImportProfilesReq) GetImportId() string {
+	if m != nil {
+		return m.ImportId
+	}
+	return ""
+}
+
+func (m *ImportProfilesReq) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ImportProfilesReq) GetProfile() *ProfileDto {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type ImportProfilesRes struct {
+	ImportId string `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	// Sequence of the next record expected. Every record before it has been accepted or rejected.
+	NextSequence uint64 `protobuf:"varint,2,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+	// Number of records accepted and rejected by the import so far, across all of its streams.
+	Accepted uint64 `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected uint64 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Records rejected since the previous progress message.
+	Errors               []*ImportRecordError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportProfilesRes) Reset()         { *m = ImportProfilesRes{} }
+func (m *ImportProfilesRes) String() string { return proto.CompactTextString(m) }
+func (*ImportProfilesRes) ProtoMessage()    {}
+func (*ImportProfilesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{22}
+}
+func (m *ImportProfilesRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportProfilesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportProfilesRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportProfilesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportProfilesRes.Merge(m, src)
+}
+func (m *ImportProfilesRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportProfilesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportProfilesRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportProfilesRes proto.InternalMessageInfo
+
+func (m *ImportProfilesRes) GetImportId() string {
+	if m != nil {
+		return m.ImportId
+	}
+	return ""
+}
+
+func (m *ImportProfilesRes) GetNextSequence() uint64 {
+	if m != nil {
+		return m.NextSequence
+	}
+	return 0
+}
+
+func (m *ImportProfilesRes) GetAccepted() uint64 {
+	if m != nil {
+		return m.Accepted
+	}
+	return 0
+}
+
+func (m *ImportProfilesRes) GetRejected() uint64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *ImportProfilesRes) GetErrors() []*ImportRecordError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ImportRecordError struct {
+	Sequence             uint64      `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status               *rpc.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ImportRecordError) Reset()         { *m = ImportRecordError{} }
+func (m *ImportRecordError) String() string { return proto.CompactTextString(m) }
+func (*ImportRecordError) ProtoMessage()    {}
+func (*ImportRecordError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{23}
+}
+func (m *ImportRecordError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRecordError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRecordError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRecordError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRecordError.Merge(m, src)
+}
+func (m *ImportRecordError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRecordError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRecordError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRecordError proto.InternalMessageInfo
+
+func (m *ImportRecordError) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ImportRecordError) GetStatus() *rpc.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type SearchProfilesReq struct {
+	// Words to search for, ignoring case and diacritics. Profiles match when each word matches a word
+	// of their first, last or display name exactly, as a prefix or, unless fuzzy matching is
+	// disabled, within 1 edit for words of 3 to 5 characters and 2 edits for longer words.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of results to return. Defaults to 20 and is capped at 100.
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	DisableFuzzy         bool     `protobuf:"varint,3,opt,name=disable_fuzzy,json=disableFuzzy,proto3" json:"disable_fuzzy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchProfilesReq) Reset()         { *m = SearchProfilesReq{} }
+func (m *SearchProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*SearchProfilesReq) ProtoMessage()    {}
+func (*SearchProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{24}
+}
+func (m *SearchProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProfilesReq.Merge(m, src)
+}
+func (m *SearchProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProfilesReq proto.InternalMessageInfo
+
+func (m *SearchProfilesReq) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchProfilesReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchProfilesReq) GetDisableFuzzy() bool {
+	if m != nil {
+		return m.DisableFuzzy
+	}
+	return false
+}
+
+type SearchProfilesRes struct {
+	Results              []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchProfilesRes) Reset()         { *m = SearchProfilesRes{} }
+func (m *SearchProfilesRes) String() string { return proto.CompactTextString(m) }
+func (*SearchProfilesRes) ProtoMessage()    {}
+func (*SearchProfilesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{25}
+}
+func (m *SearchProfilesRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProfilesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProfilesRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchProfilesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProfilesRes.Merge(m, src)
+}
+func (m *SearchProfilesRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProfilesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProfilesRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProfilesRes proto.InternalMessageInfo
+
+func (m *SearchProfilesRes) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SearchResult struct {
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Relevance of the profile to the query. Higher is more relevant.
+	Score                float64  `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResult) Reset()         { *m = SearchResult{} }
+func (m *SearchResult) String() string { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()    {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{26}
+}
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *SearchResult) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type FindDuplicatesReq struct {
+	// Maximum number of clusters to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response, to continue listing where it left off.
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindDuplicatesReq) Reset()         { *m = FindDuplicatesReq{} }
+func (m *FindDuplicatesReq) String() string { return proto.CompactTextString(m) }
+func (*FindDuplicatesReq) ProtoMessage()    {}
+func (*FindDuplicatesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{27}
+}
+func (m *FindDuplicatesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindDuplicatesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindDuplicatesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindDuplicatesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindDuplicatesReq.Merge(m, src)
+}
+func (m *FindDuplicatesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindDuplicatesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindDuplicatesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindDuplicatesReq proto.InternalMessageInfo
+
+func (m *FindDuplicatesReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *FindDuplicatesReq) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type FindDuplicatesRes struct {
+	// Clusters in order of their normalized name.
+	Clusters             []*DuplicateCluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *FindDuplicatesRes) Reset()         { *m = FindDuplicatesRes{} }
+func (m *FindDuplicatesRes) String() string { return proto.CompactTextString(m) }
+func (*FindDuplicatesRes) ProtoMessage()    {}
+func (*FindDuplicatesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{28}
+}
+func (m *FindDuplicatesRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindDuplicatesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindDuplicatesRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindDuplicatesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindDuplicatesRes.Merge(m, src)
+}
+func (m *FindDuplicatesRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindDuplicatesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindDuplicatesRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindDuplicatesRes proto.InternalMessageInfo
+
+func (m *FindDuplicatesRes) GetClusters() []*DuplicateCluster {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *FindDuplicatesRes) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DuplicateCluster struct {
+	// The normalized first and last name the profiles share.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The profiles in the cluster, oldest first.
+	Profiles             []*Profile `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DuplicateCluster) Reset()         { *m = DuplicateCluster{} }
+func (m *DuplicateCluster) String() string { return proto.CompactTextString(m) }
+func (*DuplicateCluster) ProtoMessage()    {}
+func (*DuplicateCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{29}
+}
+func (m *DuplicateCluster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateCluster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateCluster.Merge(m, src)
+}
+func (m *DuplicateCluster) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateCluster proto.InternalMessageInfo
+
+func (m *DuplicateCluster) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DuplicateCluster) GetProfiles() []*Profile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+type MergeProfilesReq struct {
+	SurvivorId string `protobuf:"bytes,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedId   string `protobuf:"bytes,2,opt,name=merged_id,json=mergedId,proto3" json:"merged_id,omitempty"`
+	// When set, the merge fails with ABORTED unless the survivor is at this revision.
+	ExpectedSurvivorRevision uint64 `protobuf:"varint,3,opt,name=expected_survivor_revision,json=expectedSurvivorRevision,proto3" json:"expected_survivor_revision,omitempty"`
+	// When set, the merge fails with ABORTED unless the merged profile is at this revision.
+	ExpectedMergedRevision uint64   `protobuf:"varint,4,opt,name=expected_merged_revision,json=expectedMergedRevision,proto3" json:"expected_merged_revision,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *MergeProfilesReq) Reset()         { *m = MergeProfilesReq{} }
+func (m *MergeProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*MergeProfilesReq) ProtoMessage()    {}
+func (*MergeProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20b2777e1b084b3a, []int{30}
+}
+func (m *MergeProfilesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeProfilesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeProfilesReq.Merge(m, src)
+}
+func (m *MergeProfilesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeProfilesReq proto.InternalMessageInfo
+
+func (m *MergeProfilesReq) GetSurvivorId() string {
+	if m != nil {
+		return m.SurvivorId
+	}
+	return ""
+}
+
+func (m *MergeProfilesReq) GetMergedId() string {
+	if m != nil {
+		return m.MergedId
+	}
+	return ""
+}
+
+func (m *MergeProfilesReq) GetExpectedSurvivorRevision() uint64 {
+	if m != nil {
+		return m.ExpectedSurvivorRevision
+	}
+	return 0
+}
+
+func (m *MergeProfilesReq) GetExpectedMergedRevision() uint64 {
+	if m != nil {
+		return m.ExpectedMergedRevision
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("profile.v1.BatchMode", BatchMode_name, BatchMode_value)
+	proto.RegisterEnum("profile.v1.ProfileEvent_Type", ProfileEvent_Type_name, ProfileEvent_Type_value)
+	proto.RegisterType((*Profile)(nil), "profile.v1.Profile")
+	proto.RegisterMapType((map[string]*types.Value)(nil), "profile.v1.Profile.CustomAttributesEntry")
+	proto.RegisterType((*ProfileDto)(nil), "profile.v1.ProfileDto")
+	proto.RegisterMapType((map[string]*types.Value)(nil), "profile.v1.ProfileDto.CustomAttributesEntry")
+	proto.RegisterType((*EmailAddress)(nil), "profile.v1.EmailAddress")
+	proto.RegisterType((*PhoneNumber)(nil), "profile.v1.PhoneNumber")
+	proto.RegisterType((*PostalAddress)(nil), "profile.v1.PostalAddress")
+	proto.RegisterType((*ReadProfileReq)(nil), "profile.v1.ReadProfileReq")
+	proto.RegisterType((*UpdateProfileReq)(nil), "profile.v1.UpdateProfileReq")
+	proto.RegisterType((*DeleteProfileReq)(nil), "profile.v1.DeleteProfileReq")
+	proto.RegisterType((*DeleteProfileRes)(nil), "profile.v1.DeleteProfileRes")
+	proto.RegisterType((*ListProfilesReq)(nil), "profile.v1.ListProfilesReq")
+	proto.RegisterType((*StreamProfilesReq)(nil), "profile.v1.StreamProfilesReq")
+	proto.RegisterType((*WatchProfilesReq)(nil), "profile.v1.WatchProfilesReq")
+	proto.RegisterType((*ProfileEvent)(nil), "profile.v1.ProfileEvent")
+	proto.RegisterType((*ListProfilesRes)(nil), "profile.v1.ListProfilesRes")
+	proto.RegisterType((*ListProfileVersionsReq)(nil), "profile.v1.ListProfileVersionsReq")
+	proto.RegisterType((*ListProfileVersionsRes)(nil), "profile.v1.ListProfileVersionsRes")
+	proto.RegisterType((*BatchCreateProfilesReq)(nil), "profile.v1.BatchCreateProfilesReq")
+	proto.RegisterType((*BatchUpdateProfilesReq)(nil), "profile.v1.BatchUpdateProfilesReq")
+	proto.RegisterType((*BatchDeleteProfilesReq)(nil), "profile.v1.BatchDeleteProfilesReq")
+	proto.RegisterType((*BatchProfilesRes)(nil), "profile.v1.BatchProfilesRes")
+	proto.RegisterType((*BatchProfileResult)(nil), "profile.v1.BatchProfileResult")
+	proto.RegisterType((*ImportProfilesReq)(nil), "profile.v1.ImportProfilesReq")
+	proto.RegisterType((*ImportProfilesRes)(nil), "profile.v1.ImportProfilesRes")
+	proto.RegisterType((*ImportRecordError)(nil), "profile.v1.ImportRecordError")
+	proto.RegisterType((*SearchProfilesReq)(nil), "profile.v1.SearchProfilesReq")
+	proto.RegisterType((*SearchProfilesRes)(nil), "profile.v1.SearchProfilesRes")
+	proto.RegisterType((*SearchResult)(nil), "profile.v1.SearchResult")
+	proto.RegisterType((*FindDuplicatesReq)(nil), "profile.v1.FindDuplicatesReq")
+	proto.RegisterType((*FindDuplicatesRes)(nil), "profile.v1.FindDuplicatesRes")
+	proto.RegisterType((*DuplicateCluster)(nil), "profile.v1.DuplicateCluster")
+	proto.RegisterType((*MergeProfilesReq)(nil), "profile.v1.MergeProfilesReq")
+}
+
+func init() { proto.RegisterFile("api/v1/profile.proto", fileDescriptor_20b2777e1b084b3a) }
+
+var fileDescriptor_20b2777e1b084b3a = []byte{
+	// 2744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xcf, 0x52, 0xa2, 0x44, 0x1d, 0xea, 0x42, 0x8d, 0x2f, 0x61, 0xe8, 0x0b, 0x37, 0xb4, 0x13,
+	0xc9, 0xb4, 0x28, 0x89, 0xb4, 0xad, 0x28, 0x71, 0xf0, 0xff, 0x5b, 0x14, 0xa9, 0x58, 0x8d, 0x64,
+	0x09, 0x2b, 0xc9, 0x89, 0x22, 0xc8, 0x8b, 0xd1, 0x72, 0x44, 0x6e, 0x4d, 0xee, 0xd2, 0x3b, 0x43,
+	0x59, 0xb2, 0xa3, 0x20, 0x0f, 0x05, 0x8a, 0xa0, 0x2f, 0x05, 0x0a, 0xf4, 0xa1, 0x40, 0xd1, 0x87,
+	0x3e, 0xf4, 0x8b, 0xf4, 0xa1, 0xe8, 0x53, 0x8b, 0xbe, 0x14, 0x7d, 0x61, 0xc1, 0x2f, 0x92, 0x62,
+	0x66, 0x2f, 0xdc, 0xe5, 0xcd, 0x8a, 0xeb, 0xfa, 0xc1, 0xda, 0x99, 0x73, 0xe6, 0x77, 0x66, 0xce,
+	0x9c, 0xf3, 0x3b, 0x67, 0x08, 0x97, 0x71, 0x5d, 0x5f, 0x38, 0xc9, 0x2e, 0xd4, 0x2d, 0xf3, 0x58,
+	0xaf, 0x92, 0xf9, 0xba, 0x65, 0x32, 0x13, 0x81, 0x3b, 0x3c, 0xc9, 0x26, 0xe4, 0xb2, 0x69, 0x96,
+	0xab, 0x64, 0x41, 0x48, 0x8e, 0x1a, 0xc7, 0x0b, 0xc7, 0x3a, 0xa9, 0x96, 0xd4, 0x1a, 0xa6, 0xcf,
+	0x6d, 0xed, 0xc4, 0xf5, 0x4e, 0x0d, 0xca, 0xac, 0x86, 0xc6, 0x1c, 0x69, 0xb2, 0x53, 0xca, 0xf4,
+	0x1a, 0xa1, 0x0c, 0xd7, 0xea, 0x8e, 0xc2, 0xfb, 0x8e, 0x82, 0x55, 0xd7, 0x16, 0x28, 0xc3, 0xac,
+	0x41, 0x1d, 0xc1, 0xe5, 0xb2, 0x59, 0x36, 0xc5, 0xe7, 0x02, 0xff, 0x72, 0x66, 0x97, 0xca, 0x3a,
+	0xab, 0x34, 0x8e, 0xe6, 0x35, 0xb3, 0xb6, 0x50, 0x7b, 0xa9, 0xb3, 0xe7, 0xe6, 0xcb, 0x85, 0xb2,
+	0x99, 0x11, 0xc2, 0xcc, 0x09, 0xae, 0xea, 0x25, 0xcc, 0x4c, 0x8b, 0x2e, 0x78, 0x9f, 0xf6, 0xba,
+	0xd4, 0xbf, 0x46, 0x60, 0x74, 0xdb, 0x3e, 0x16, 0x9a, 0x84, 0x90, 0x5e, 0x8a, 0x4b, 0xb2, 0x34,
+	0x3b, 0xa6, 0x84, 0xf4, 0x12, 0xba, 0x01, 0x70, 0xac, 0x5b, 0x94, 0xa9, 0x06, 0xae, 0x91, 0x78,
+	0x48, 0xcc, 0x8f, 0x89, 0x99, 0x27, 0xb8, 0x46, 0xd0, 0x35, 0x18, 0xab, 0x62, 0x57, 0x3a, 0x24,
+	0xa4, 0x91, 0x2a, 0x76, 0x84, 0x2b, 0x10, 0xd5, 0x2c, 0x82, 0x19, 0x51, 0x4b, 0x98, 0x91, 0xf8,
+	0xb0, 0x2c, 0xcd, 0x46, 0x73, 0x89, 0x79, 0xfb, 0x50, 0xf3, 0xee, 0xa9, 0xe7, 0x77, 0xdd, 0x53,
+	0xe7, 0x87, 0x7f, 0xdd, 0x4c, 0x4a, 0x0a, 0xd8, 0x8b, 0x0a, 0x98, 0x09, 0x88, 0x46, 0xbd, 0xe4,
+	0x41, 0x84, 0x2f, 0x0a, 0x61, 0x2f, 0x72, 0x21, 0x4a, 0xa4, 0x4a, 0x5c, 0x88, 0x91, 0x8b, 0x42,
+	0xd8, 0x8b, 0x04, 0x44, 0x02, 0x22, 0x16, 0x39, 0xd1, 0xa9, 0x6e, 0x1a, 0xf1, 0x51, 0x59, 0x9a,
+	0x1d, 0x56, 0xbc, 0x31, 0x77, 0x90, 0x6d, 0xac, 0xa4, 0x1e, 0x9d, 0xc5, 0x23, 0xb6, 0x83, 0x9c,
+	0x99, 0xfc, 0x19, 0xfa, 0x10, 0xc6, 0x4b, 0x3a, 0xad, 0x57, 0xf1, 0x99, 0xed, 0xa3, 0x31, 0xa1,
+	0x10, 0x75, 0xe6, 0x84, 0x9b, 0x16, 0x61, 0x84, 0xd4, 0xb0, 0x5e, 0xa5, 0x71, 0x90, 0x87, 0x66,
+	0xa3, 0xb9, 0xf8, 0x7c, 0x3b, 0xc6, 0xe6, 0x8b, 0x5c, 0xb2, 0x52, 0x2a, 0x59, 0x84, 0x52, 0xc5,
+	0xd1, 0x43, 0x9f, 0xc3, 0x44, 0xbd, 0x62, 0x1a, 0x44, 0x35, 0x1a, 0xb5, 0x23, 0x62, 0xd1, 0x78,
+	0x54, 0x2c, 0x7c, 0xdf, 0xbf, 0x70, 0x9b, 0x2b, 0x3c, 0x11, 0x72, 0x65, 0xbc, 0xde, 0x1e, 0x50,
+	0x54, 0x80, 0x58, 0xdd, 0xa4, 0x0c, 0x57, 0x55, 0x6c, 0xe3, 0x12, 0x1a, 0x1f, 0x17, 0x00, 0x1f,
+	0x04, 0x00, 0x84, 0x8e, 0x6b, 0x7a, 0xaa, 0xee, 0x1f, 0x12, 0x8a, 0x52, 0x30, 0x21, 0xee, 0xc5,
+	0x3c, 0x56, 0x8f, 0x74, 0x8b, 0x55, 0xe2, 0x13, 0xce, 0xc9, 0x30, 0x23, 0x5b, 0xc7, 0x79, 0x3e,
+	0x85, 0xae, 0xc2, 0x48, 0xd5, 0xd4, 0x70, 0x95, 0xc4, 0x27, 0x85, 0xd0, 0x19, 0xa1, 0xa7, 0x30,
+	0xad, 0x35, 0x28, 0x33, 0x6b, 0x2a, 0x66, 0xcc, 0xd2, 0x8f, 0x1a, 0x8c, 0xd0, 0xf8, 0x94, 0xd8,
+	0xc2, 0x9d, 0xc0, 0x16, 0x9c, 0xcf, 0x55, 0xa1, 0xbc, 0xe2, 0xe9, 0x16, 0x0d, 0x66, 0x9d, 0x29,
+	0x31, 0xad, 0x63, 0x1a, 0x25, 0x21, 0x5a, 0x23, 0x56, 0x99, 0x94, 0x54, 0xdd, 0x60, 0x66, 0x3c,
+	0x26, 0x8c, 0x82, 0x3d, 0xb5, 0x6e, 0x30, 0x13, 0x5d, 0x86, 0xb0, 0xf9, 0xd2, 0x20, 0x56, 0x7c,
+	0x5a, 0x88, 0xec, 0x41, 0xe2, 0x00, 0xae, 0xf4, 0xb4, 0x80, 0x62, 0x30, 0xf4, 0x9c, 0x9c, 0x39,
+	0xd9, 0xc0, 0x3f, 0xd1, 0x1c, 0x84, 0x4f, 0x70, 0xb5, 0x61, 0x67, 0x42, 0x34, 0x77, 0xb5, 0x2b,
+	0x8c, 0x9e, 0x72, 0xa9, 0x62, 0x2b, 0x7d, 0x16, 0x5a, 0x96, 0x52, 0x7f, 0x18, 0x03, 0x70, 0xce,
+	0x51, 0x60, 0x26, 0x5a, 0x0e, 0xe4, 0x93, 0x40, 0xce, 0x7f, 0xd0, 0x6a, 0x26, 0xaf, 0xa4, 0xa7,
+	0x6b, 0x0d, 0xca, 0x64, 0xc3, 0x64, 0xf2, 0x11, 0x91, 0x49, 0xad, 0xce, 0xce, 0xbe, 0x96, 0xfc,
+	0xa9, 0xb6, 0xe4, 0x4f, 0xb5, 0xd0, 0x9b, 0x16, 0xb6, 0xb3, 0x70, 0x0f, 0xa6, 0xf4, 0x12, 0xa9,
+	0xd5, 0x4d, 0x46, 0x0c, 0xed, 0x4c, 0xe5, 0x07, 0x12, 0x89, 0x9a, 0x9f, 0x6b, 0x35, 0x93, 0xb3,
+	0xa7, 0xdf, 0x87, 0xd2, 0xb7, 0x04, 0xc0, 0x11, 0x91, 0x69, 0xc5, 0xb4, 0x18, 0xb1, 0x64, 0x56,
+	0xc1, 0x86, 0x9c, 0x7b, 0xb0, 0x24, 0x6b, 0x15, 0x6c, 0x61, 0x8d, 0x11, 0x8b, 0x2a, 0x93, 0x3e,
+	0x90, 0x2f, 0xc9, 0x19, 0xfa, 0x9d, 0x24, 0x98, 0x62, 0x58, 0x40, 0xfd, 0x20, 0xb5, 0x9a, 0xc9,
+	0x5f, 0x48, 0xe9, 0x6f, 0x5c, 0x24, 0xcc, 0xe4, 0x9a, 0x49, 0x99, 0x9c, 0xcd, 0x2d, 0xcb, 0x55,
+	0xc2, 0x38, 0xc2, 0x9c, 0x5c, 0xd2, 0xcb, 0x3a, 0xa3, 0x73, 0xf2, 0xcc, 0xfc, 0xcc, 0x9c, 0x3c,
+	0xa3, 0xf2, 0xff, 0xbe, 0x9b, 0x91, 0x4d, 0x4b, 0x9e, 0xc9, 0xcc, 0xcc, 0xc9, 0x94, 0x61, 0x8b,
+	0xe9, 0x46, 0x59, 0x7e, 0xa9, 0xb3, 0x8a, 0x8c, 0x9d, 0x65, 0x5c, 0x2c, 0xd6, 0xc1, 0xc7, 0xcf,
+	0x66, 0x0f, 0x56, 0x32, 0xdf, 0xe0, 0xcc, 0xab, 0xc5, 0xcc, 0xa7, 0x87, 0xed, 0xcf, 0x79, 0xf5,
+	0xbb, 0xcc, 0xe1, 0xeb, 0xc5, 0xb9, 0x6c, 0xee, 0x93, 0xf3, 0x3b, 0xff, 0x7f, 0x5b, 0xb0, 0xd6,
+	0x56, 0x47, 0xd6, 0x85, 0xdb, 0x07, 0xbe, 0xd0, 0x69, 0x4f, 0xbf, 0x0f, 0x05, 0x73, 0xf4, 0xa9,
+	0x97, 0xa3, 0x23, 0x83, 0x73, 0x34, 0x7f, 0xbb, 0xd5, 0x4c, 0xca, 0xe9, 0xeb, 0xc2, 0x48, 0x05,
+	0x9f, 0xf8, 0x5c, 0xb1, 0x28, 0x13, 0x83, 0x59, 0x3a, 0xa1, 0x15, 0xf0, 0x32, 0xf9, 0xa8, 0x33,
+	0x93, 0x47, 0x07, 0x66, 0xb2, 0x8d, 0x5e, 0x81, 0xc1, 0xf8, 0x1d, 0xf9, 0xae, 0xf7, 0xc8, 0xf7,
+	0xc8, 0x1b, 0xf2, 0x3d, 0x7f, 0xab, 0xd5, 0x4c, 0x26, 0x2b, 0xe1, 0xf4, 0xb5, 0x6e, 0x43, 0x0f,
+	0x3c, 0x3b, 0x5d, 0xa4, 0xf0, 0x4b, 0xa9, 0x93, 0x15, 0x04, 0xdf, 0xe5, 0xb5, 0x56, 0x33, 0xa9,
+	0xa6, 0x3f, 0xf2, 0xa2, 0x43, 0xe6, 0x4a, 0xb2, 0x6e, 0xc8, 0xac, 0x42, 0xe4, 0x63, 0xd3, 0xaa,
+	0xc9, 0xfb, 0xfb, 0xfb, 0xfb, 0x99, 0xcd, 0xcd, 0x4c, 0xa1, 0x00, 0x4b, 0xcf, 0x66, 0x0f, 0xf8,
+	0x15, 0xbf, 0xbe, 0x7f, 0x9e, 0x99, 0x5d, 0x3c, 0xc8, 0x66, 0x3e, 0x3d, 0xfc, 0x36, 0x7b, 0xb0,
+	0x98, 0xc9, 0x1d, 0xde, 0xf1, 0xc6, 0x07, 0xd9, 0xdc, 0xa1, 0x50, 0xfa, 0xf6, 0xde, 0xc1, 0x62,
+	0xf6, 0xf0, 0x0e, 0xbf, 0xf8, 0x00, 0xf5, 0xbc, 0xf0, 0xa8, 0x07, 0xc4, 0x0e, 0xf6, 0x5b, 0xcd,
+	0xe4, 0x5e, 0xfa, 0x6e, 0x7b, 0x07, 0xf9, 0xd5, 0x6d, 0xf9, 0xfe, 0x27, 0x72, 0x15, 0x1b, 0xe5,
+	0x06, 0x2e, 0x13, 0x99, 0xe1, 0xb2, 0x4c, 0x1b, 0x5a, 0x45, 0xc6, 0x54, 0x26, 0x46, 0x66, 0x6f,
+	0xc7, 0x17, 0x70, 0x87, 0xaf, 0x73, 0x73, 0xf7, 0xce, 0x67, 0x33, 0xbe, 0xf8, 0x7b, 0x9d, 0x9b,
+	0x5b, 0x3e, 0xbf, 0x93, 0xe6, 0x76, 0x5d, 0x56, 0xdb, 0xef, 0xc5, 0x6a, 0x36, 0x33, 0xcf, 0xf5,
+	0x60, 0xb5, 0x02, 0x33, 0x2f, 0x4a, 0x6c, 0xff, 0x5b, 0x86, 0xfa, 0x93, 0x04, 0xe3, 0xfe, 0x10,
+	0x46, 0x7b, 0x30, 0xea, 0x44, 0x8a, 0x43, 0x50, 0x0f, 0x5b, 0xcd, 0xe4, 0x27, 0x10, 0x7f, 0x76,
+	0xf0, 0xec, 0x91, 0x7c, 0x78, 0xf7, 0x91, 0xfd, 0xe7, 0x60, 0xfe, 0xd0, 0xfe, 0xb8, 0x7d, 0xfa,
+	0xa3, 0x94, 0x8e, 0x7b, 0x9e, 0x35, 0x64, 0x11, 0xdb, 0xb2, 0x03, 0xa1, 0xb8, 0x58, 0xe8, 0x11,
+	0x84, 0xab, 0xf8, 0x88, 0x54, 0x1d, 0xf2, 0x4a, 0xb7, 0x9a, 0xc9, 0x8f, 0x4f, 0x1f, 0xa5, 0x53,
+	0x3d, 0xf3, 0x71, 0xe9, 0xbe, 0x9f, 0x7c, 0xec, 0x85, 0xa9, 0x3f, 0x4a, 0x10, 0xf5, 0x65, 0x03,
+	0x52, 0x60, 0xc4, 0xce, 0x1b, 0x67, 0x9f, 0x9f, 0xb5, 0x9a, 0xc9, 0x25, 0xb8, 0xf2, 0xec, 0xe0,
+	0xee, 0xa1, 0x08, 0x13, 0x3b, 0x8c, 0xb2, 0x73, 0xd9, 0xfb, 0xe7, 0xb7, 0xd3, 0x37, 0x7c, 0x3b,
+	0x2c, 0xce, 0x67, 0x97, 0xee, 0xcb, 0x22, 0x4b, 0x64, 0x1b, 0x41, 0x71, 0x90, 0xde, 0xc1, 0x2e,
+	0x7f, 0x33, 0x0c, 0x13, 0x81, 0x64, 0x42, 0xcf, 0x61, 0xc2, 0x71, 0x82, 0x5a, 0xd5, 0x0d, 0xc2,
+	0xdd, 0x3a, 0x34, 0x3b, 0x96, 0x5f, 0x6b, 0x35, 0x93, 0xf9, 0x4a, 0x98, 0x53, 0xf0, 0xc3, 0x5e,
+	0x69, 0x26, 0x74, 0xe7, 0x64, 0x82, 0xb5, 0xca, 0x40, 0x6a, 0x1e, 0x77, 0xc0, 0x37, 0xb8, 0x3e,
+	0x7a, 0x0c, 0x11, 0x11, 0x90, 0x3a, 0x3b, 0x8b, 0x87, 0xda, 0xbc, 0x77, 0xfa, 0xbd, 0xd4, 0x87,
+	0xfa, 0x38, 0x47, 0xfb, 0xd0, 0xbc, 0xd5, 0xe8, 0x10, 0x2e, 0xe1, 0x52, 0x4d, 0x37, 0x74, 0xca,
+	0x2c, 0xcc, 0xf4, 0x13, 0xa2, 0x62, 0x8b, 0xe0, 0xf8, 0xd0, 0x5b, 0x80, 0xa2, 0x20, 0xd0, 0x8a,
+	0x45, 0x30, 0xfa, 0x12, 0xa2, 0x0e, 0x2f, 0x69, 0x66, 0x89, 0xc4, 0x87, 0x7d, 0xfe, 0x96, 0xfb,
+	0xf8, 0xfb, 0x5e, 0xce, 0x0f, 0x0a, 0xf6, 0xf2, 0x55, 0xb3, 0x44, 0xd0, 0x21, 0x44, 0x2d, 0x52,
+	0xd6, 0x4d, 0xc3, 0x06, 0xb3, 0x09, 0xff, 0xf3, 0x56, 0x33, 0xb9, 0x0c, 0xf0, 0x8c, 0xe7, 0xed,
+	0xe1, 0xeb, 0xdc, 0xf9, 0xed, 0x74, 0xda, 0x17, 0x04, 0xeb, 0x3b, 0x5b, 0xf2, 0xbd, 0xec, 0xd2,
+	0x52, 0x26, 0x2b, 0xe3, 0x6a, 0xbd, 0x82, 0x33, 0x39, 0x59, 0x33, 0x1b, 0x3c, 0xad, 0x64, 0x8e,
+	0xa1, 0x80, 0x0d, 0x28, 0xe0, 0xbd, 0xa8, 0x18, 0x79, 0xdb, 0xa8, 0x78, 0x05, 0x93, 0x0a, 0xc1,
+	0x25, 0x27, 0xf9, 0x15, 0xf2, 0xa2, 0xab, 0xd5, 0xfe, 0x10, 0xc6, 0x69, 0xc5, 0x7c, 0xa9, 0xda,
+	0x8d, 0x67, 0x49, 0x5c, 0x5e, 0x44, 0x89, 0xf2, 0xb9, 0x82, 0x3d, 0x85, 0x1e, 0x40, 0x18, 0x53,
+	0xd5, 0x3c, 0x8e, 0x0f, 0x5d, 0xb0, 0x8b, 0x1d, 0xc6, 0x74, 0xeb, 0x38, 0xf5, 0x0f, 0x09, 0x62,
+	0x7b, 0xa2, 0x25, 0x1d, 0x60, 0xbe, 0x08, 0xa3, 0x0e, 0x49, 0x79, 0xd4, 0xd1, 0x93, 0xb4, 0xf2,
+	0x97, 0x5a, 0xcd, 0xe4, 0x94, 0x2c, 0xa5, 0xa3, 0xde, 0xe1, 0x09, 0x53, 0xdc, 0xb5, 0xe8, 0xa1,
+	0xd7, 0xb1, 0xf3, 0x77, 0x50, 0xdf, 0x8d, 0xae, 0xf1, 0xa7, 0xd2, 0x26, 0xa6, 0xcf, 0xdd, 0x5e,
+	0x9d, 0x7f, 0xa3, 0xbb, 0x30, 0x4d, 0x4e, 0xeb, 0x44, 0xe3, 0xdd, 0xb4, 0xd7, 0x71, 0x0f, 0x8b,
+	0x8e, 0x3b, 0xe6, 0x0a, 0x14, 0x67, 0x3e, 0xb5, 0x05, 0x31, 0xdb, 0x2f, 0x03, 0x0e, 0xd5, 0x13,
+	0x30, 0xd4, 0x07, 0x70, 0xae, 0x0b, 0x90, 0xa2, 0x38, 0x8c, 0xd2, 0x86, 0xa6, 0xb9, 0x5c, 0x18,
+	0x51, 0xdc, 0x61, 0xea, 0xaf, 0x12, 0x4c, 0x6d, 0xe8, 0x94, 0x39, 0xca, 0x94, 0x9b, 0xcf, 0xc3,
+	0x58, 0x1d, 0x97, 0x89, 0x4a, 0xf5, 0x57, 0x76, 0x73, 0x17, 0xce, 0x7f, 0xd4, 0x6a, 0x26, 0x3f,
+	0x4c, 0x5f, 0xf6, 0xf7, 0x68, 0x06, 0x29, 0x8b, 0x2c, 0x88, 0xfd, 0xe8, 0xfe, 0x93, 0x94, 0x08,
+	0x5f, 0xb7, 0xa3, 0xbf, 0x22, 0xfc, 0x41, 0x21, 0x30, 0x98, 0xf9, 0x9c, 0x18, 0xee, 0x8b, 0x8b,
+	0xcf, 0xec, 0xf2, 0x09, 0xf4, 0x01, 0x44, 0x4c, 0xab, 0x44, 0x2c, 0xfe, 0xda, 0xb0, 0x1f, 0x5c,
+	0xa3, 0x62, 0x9c, 0x3f, 0xe3, 0xed, 0xf6, 0xb1, 0x5e, 0x65, 0xc4, 0xb2, 0x73, 0x49, 0x71, 0x46,
+	0x5d, 0x81, 0x15, 0xee, 0x0a, 0xac, 0x94, 0x0e, 0xd3, 0x3b, 0xcc, 0x22, 0xb8, 0xe6, 0x3f, 0x8d,
+	0xdf, 0x94, 0xd4, 0xcf, 0x54, 0x68, 0xa0, 0xa9, 0xa1, 0x6e, 0x53, 0x0f, 0x21, 0xf6, 0x15, 0x66,
+	0x5a, 0xc5, 0x6f, 0x69, 0x06, 0xa6, 0x2c, 0x42, 0x1b, 0x35, 0xd2, 0xbe, 0x24, 0x49, 0x5c, 0xd2,
+	0xa4, 0x3d, 0xed, 0x5d, 0xd1, 0xdf, 0x25, 0x18, 0x77, 0x16, 0x16, 0x4f, 0x88, 0xc1, 0x50, 0x16,
+	0x86, 0xd9, 0x59, 0xdd, 0x76, 0xf6, 0x64, 0xee, 0x46, 0x8f, 0x90, 0x15, 0x7a, 0xf3, 0xbb, 0x67,
+	0x75, 0xa2, 0x08, 0x55, 0x94, 0xe9, 0x0c, 0xf4, 0x4b, 0x3d, 0x56, 0xb5, 0x03, 0xda, 0xff, 0xf8,
+	0x1b, 0x0a, 0x3e, 0xfe, 0x52, 0xab, 0x30, 0xcc, 0x81, 0xd1, 0x65, 0x88, 0xed, 0xee, 0x6f, 0x17,
+	0xd5, 0xbd, 0x27, 0x3b, 0xdb, 0xc5, 0xd5, 0xf5, 0xb5, 0xf5, 0x62, 0x21, 0xf6, 0x1e, 0x8a, 0xc2,
+	0xe8, 0xaa, 0x52, 0x5c, 0xd9, 0x2d, 0x16, 0x62, 0x12, 0x1f, 0xec, 0x6d, 0x17, 0xc4, 0x20, 0xc4,
+	0x07, 0x85, 0xe2, 0x46, 0x91, 0x0f, 0x86, 0x52, 0x3f, 0x74, 0x05, 0x12, 0x45, 0x0b, 0x10, 0x71,
+	0xec, 0xdb, 0xc5, 0xa2, 0xcf, 0x26, 0x3d, 0x25, 0xf4, 0x31, 0x4c, 0x19, 0xe4, 0x94, 0xa9, 0x5d,
+	0xa1, 0x33, 0xc1, 0xa7, 0xb7, 0xbd, 0xf0, 0xb9, 0x01, 0xc0, 0x4c, 0xce, 0xb9, 0x22, 0x44, 0xf9,
+	0x79, 0xc2, 0xca, 0x98, 0x98, 0xe1, 0xc1, 0x97, 0xfa, 0x95, 0x04, 0x57, 0x7d, 0x7b, 0x79, 0x4a,
+	0x2c, 0x7e, 0x4e, 0xda, 0x2b, 0xb5, 0x02, 0xb1, 0x1e, 0x7a, 0x17, 0xb1, 0x3e, 0xd4, 0x11, 0xeb,
+	0xa9, 0x17, 0x7d, 0x36, 0x23, 0xfc, 0x73, 0xe2, 0x0c, 0x07, 0xfa, 0xc7, 0x55, 0xba, 0xa8, 0x7f,
+	0x52, 0x2f, 0xe1, 0x6a, 0x9e, 0x47, 0xe7, 0xaa, 0xf8, 0x0d, 0xc2, 0x1f, 0xa3, 0xb9, 0xae, 0x2b,
+	0xe9, 0x43, 0x90, 0xbe, 0x5b, 0xb9, 0x03, 0xc3, 0x35, 0x5e, 0x8e, 0x42, 0x22, 0x3a, 0xaf, 0xf8,
+	0xf5, 0x85, 0x95, 0x4d, 0x5e, 0x67, 0x84, 0x4a, 0xea, 0xdc, 0x31, 0x1c, 0xe0, 0x69, 0x61, 0x78,
+	0x99, 0x07, 0xe0, 0x8b, 0x06, 0xa1, 0xcc, 0x35, 0x7c, 0xdd, 0x0f, 0xd4, 0x49, 0xec, 0x8a, 0xa7,
+	0xfd, 0x36, 0xe6, 0x03, 0x04, 0x78, 0x11, 0xf3, 0x9d, 0x14, 0xfc, 0x76, 0xe6, 0x37, 0x20, 0x96,
+	0x0f, 0x92, 0x02, 0x45, 0xcb, 0x30, 0xca, 0xb3, 0xbf, 0xea, 0xd9, 0xbd, 0xd9, 0x85, 0xd0, 0x26,
+	0xea, 0x46, 0x95, 0x29, 0xae, 0x7a, 0xca, 0x04, 0xd4, 0x2d, 0x46, 0x69, 0x18, 0xb1, 0x7f, 0x44,
+	0x13, 0x41, 0x1c, 0xcd, 0x21, 0xb7, 0x28, 0x59, 0x75, 0x6d, 0x7e, 0x47, 0x48, 0x14, 0x47, 0xe3,
+	0x27, 0x72, 0x44, 0xea, 0x3b, 0x98, 0x5e, 0xaf, 0xd5, 0x4d, 0x2b, 0x50, 0x0c, 0xae, 0xc1, 0x98,
+	0x2e, 0x26, 0x55, 0x2f, 0x6f, 0x22, 0xf6, 0xc4, 0x7a, 0x89, 0xb3, 0x0a, 0xe5, 0x7e, 0x32, 0x34,
+	0xe2, 0xd4, 0x23, 0x6f, 0x8c, 0x16, 0xdb, 0xc6, 0x87, 0x06, 0x55, 0xe2, 0xb6, 0xfd, 0x3f, 0x4b,
+	0xdd, 0x1b, 0xa0, 0x83, 0x37, 0x70, 0x0b, 0x44, 0xe4, 0xab, 0x1d, 0xbb, 0x18, 0xe7, 0x93, 0x3b,
+	0xee, 0x4e, 0x12, 0x10, 0xc1, 0x9a, 0x46, 0xea, 0x2e, 0x95, 0x0f, 0x2b, 0xde, 0xd8, 0xe6, 0xc5,
+	0x9f, 0x8b, 0x0a, 0xea, 0x94, 0x68, 0x6f, 0x8c, 0x1e, 0xc0, 0x08, 0xb1, 0x2c, 0xd3, 0xa2, 0xf1,
+	0xb0, 0xb8, 0xb9, 0x00, 0x2f, 0xdb, 0x1b, 0x55, 0x88, 0x66, 0x5a, 0xa5, 0x22, 0xd7, 0x52, 0x1c,
+	0xe5, 0xd4, 0x01, 0x4c, 0x77, 0x09, 0x03, 0x9e, 0x92, 0x3a, 0x3c, 0xd5, 0xbe, 0xd2, 0xd0, 0x9b,
+	0xae, 0x34, 0xf5, 0x7b, 0x09, 0xa6, 0x77, 0x08, 0xb6, 0x82, 0x95, 0x27, 0x09, 0xe1, 0x17, 0x0d,
+	0x62, 0x39, 0x05, 0x2e, 0x3f, 0xd6, 0x6a, 0x26, 0xc3, 0x5f, 0x4b, 0xfc, 0xfd, 0x6f, 0xcf, 0xf7,
+	0xa5, 0x39, 0x1f, 0xa1, 0xf5, 0x66, 0x3c, 0x1f, 0xcd, 0xdd, 0x82, 0x89, 0x92, 0x4e, 0xf1, 0x51,
+	0x95, 0xa8, 0xc7, 0x8d, 0x57, 0xaf, 0xce, 0x9c, 0xb2, 0x38, 0xee, 0x4c, 0xae, 0xf1, 0xb9, 0xd4,
+	0x17, 0xdd, 0xdb, 0xa3, 0x28, 0xd7, 0x99, 0x03, 0x81, 0x1f, 0x1e, 0x6c, 0xfd, 0xce, 0xe8, 0xdf,
+	0x81, 0x71, 0xbf, 0xc0, 0x1f, 0xcb, 0xd2, 0x05, 0xea, 0xdd, 0x65, 0x08, 0x53, 0xcd, 0xb4, 0xec,
+	0xc3, 0x4a, 0x8a, 0x3d, 0x48, 0x9d, 0xc0, 0xf4, 0x9a, 0x6e, 0x94, 0x0a, 0x8d, 0x7a, 0x55, 0xd7,
+	0x30, 0x1b, 0xdc, 0xee, 0xfc, 0x14, 0xdf, 0x0c, 0x6e, 0x77, 0x52, 0x8d, 0x6e, 0xbb, 0x9c, 0x19,
+	0x22, 0x5a, 0xb5, 0x41, 0x19, 0xb1, 0x7a, 0x53, 0x92, 0xab, 0xbc, 0x6a, 0x2b, 0x29, 0x9e, 0xf6,
+	0x85, 0xcb, 0xc0, 0x57, 0x10, 0xeb, 0x44, 0x41, 0x08, 0x86, 0xdb, 0x3f, 0xda, 0x29, 0xe2, 0x3b,
+	0x50, 0xa7, 0x43, 0x17, 0xa8, 0xd3, 0xa9, 0x7f, 0x4a, 0x10, 0xdb, 0x24, 0x56, 0x99, 0x04, 0xdb,
+	0x9f, 0x28, 0x6d, 0x58, 0x27, 0xfa, 0x89, 0x69, 0x79, 0xa9, 0x9a, 0x1f, 0x69, 0x35, 0x93, 0xa1,
+	0xaf, 0x25, 0x05, 0x5c, 0x91, 0x48, 0xda, 0x31, 0xf7, 0x07, 0xce, 0x52, 0x3c, 0x14, 0x50, 0x8b,
+	0x38, 0x3f, 0x73, 0x96, 0xd0, 0xe7, 0x90, 0xf0, 0x7a, 0x5e, 0x0f, 0xb6, 0xa3, 0x85, 0x89, 0xbb,
+	0x1a, 0x3b, 0x8e, 0x82, 0xdb, 0x61, 0xa1, 0x65, 0xf0, 0x64, 0xaa, 0x63, 0xab, 0xa3, 0x13, 0xbf,
+	0xea, 0xca, 0xc5, 0x39, 0xbc, 0xf6, 0x39, 0xbd, 0x01, 0x63, 0x1e, 0xad, 0xa3, 0x04, 0x5c, 0xcd,
+	0xaf, 0xec, 0xae, 0x3e, 0x56, 0x37, 0xb7, 0x0a, 0x9d, 0x7d, 0x11, 0x82, 0xc9, 0x95, 0x8d, 0x0d,
+	0x75, 0x4b, 0x51, 0x9f, 0x6c, 0xed, 0x3e, 0x5e, 0x7f, 0xf2, 0x45, 0x4c, 0x42, 0x53, 0x10, 0xcd,
+	0x17, 0x77, 0x76, 0xd5, 0xe2, 0xda, 0xda, 0x96, 0xb2, 0x1b, 0x0b, 0xe5, 0x7e, 0x1b, 0x85, 0x49,
+	0xc7, 0x47, 0x3b, 0xc4, 0x3a, 0xd1, 0x35, 0x82, 0xfe, 0x0f, 0x26, 0x02, 0x65, 0x19, 0xf5, 0xe1,
+	0xc5, 0x44, 0xaf, 0x3b, 0x48, 0xbd, 0x87, 0x1e, 0x41, 0xd4, 0xf7, 0x04, 0x43, 0x09, 0xbf, 0x56,
+	0xf0, 0x6d, 0xd6, 0x0f, 0xa1, 0x00, 0x13, 0x81, 0x72, 0x8b, 0x06, 0x56, 0xe2, 0x81, 0x28, 0x94,
+	0x58, 0xec, 0xbf, 0x42, 0xd9, 0x84, 0x89, 0x40, 0xed, 0x45, 0x03, 0xcb, 0x72, 0x62, 0x90, 0x94,
+	0x8a, 0x4d, 0x4d, 0xed, 0x19, 0xa5, 0x00, 0xe0, 0x5b, 0x38, 0x68, 0x03, 0xc6, 0xb7, 0x1b, 0x56,
+	0xf9, 0x1d, 0xed, 0xe9, 0x67, 0x30, 0xee, 0x6f, 0x8c, 0xd1, 0x35, 0xbf, 0x7e, 0xc7, 0xdb, 0x2b,
+	0x31, 0x40, 0xc8, 0xb1, 0x1e, 0xc3, 0x64, 0xf0, 0x85, 0x83, 0x02, 0x45, 0xa9, 0xeb, 0xf5, 0xd3,
+	0xe7, 0x84, 0x8b, 0x12, 0xfa, 0x12, 0x26, 0x02, 0x0f, 0x98, 0xe0, 0x21, 0x3b, 0xdf, 0x36, 0x89,
+	0x78, 0xbf, 0x37, 0x89, 0x00, 0x53, 0xe1, 0x52, 0x8f, 0x16, 0x17, 0xa5, 0xfa, 0x1c, 0xc6, 0xd7,
+	0x90, 0x27, 0xde, 0xac, 0xc3, 0xcf, 0xbd, 0x0f, 0x97, 0x7a, 0x34, 0xb4, 0x41, 0x03, 0xbd, 0x3b,
+	0xde, 0xc4, 0xf5, 0x2e, 0x9d, 0xa0, 0x4b, 0x5d, 0xe8, 0x60, 0xcb, 0xda, 0x03, 0xba, 0xab, 0xa7,
+	0xbd, 0x30, 0x74, 0xb0, 0x1d, 0xed, 0x01, 0xdd, 0xd5, 0xaf, 0xbe, 0x11, 0x7a, 0x17, 0x26, 0x83,
+	0xad, 0x12, 0xea, 0xd1, 0x9d, 0xf8, 0x01, 0x07, 0x8a, 0x69, 0xea, 0xbd, 0x59, 0x69, 0x51, 0x42,
+	0xdb, 0x30, 0x19, 0xac, 0xde, 0x1d, 0xe1, 0xd5, 0xd9, 0x78, 0x24, 0x06, 0x8a, 0xf9, 0x3e, 0xb7,
+	0x61, 0x32, 0x58, 0xf9, 0x82, 0x88, 0x5d, 0xd5, 0x38, 0x31, 0x50, 0x6c, 0xa7, 0xf8, 0x44, 0xa0,
+	0xf4, 0x04, 0x03, 0xb7, 0xb3, 0x2a, 0xf5, 0x49, 0x80, 0xfc, 0xf8, 0x5f, 0x5a, 0x37, 0xa5, 0xbf,
+	0xb5, 0x6e, 0x4a, 0xff, 0x6e, 0xdd, 0x94, 0x8e, 0x46, 0xc4, 0x2f, 0x3a, 0xf7, 0xfe, 0x33, 0x00,
+	0x42, 0x8f, 0xc2, 0xb1, 0x2e, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProfileServiceClient is the client API for ProfileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProfileServiceClient interface {
+	// Creates a profile. Depending on the server's duplicate mode, profiles with the same normalized
+	// first and last name as an existing one are either allowed, allowed with the ids of the existing
+	// profiles in the duplicate-profile-ids trailer, or rejected with ALREADY_EXISTS.
+	CreateProfile(ctx context.Context, in *ProfileDto, opts ...grpc.CallOption) (*Profile, error)
+	// Reads a profile. Reading a profile that was merged into another reads the profile it was
+	// merged into.
+	ReadProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*Profile, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error)
+	// Replaces the profile with the given id, or creates it with that id if there is none. Soft
+	// deleted profiles must be undeleted or purged first.
+	UpsertProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error)
+	// Soft deletes a profile, leaving a tombstone that can be undeleted until it is purged.
+	DeleteProfile(ctx context.Context, in *DeleteProfileReq, opts ...grpc.CallOption) (*DeleteProfileRes, error)
+	UndeleteProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*Profile, error)
+	// Permanently removes a profile, whether or not it has been soft deleted.
+	PurgeProfile(ctx context.Context, in *DeleteProfileReq, opts ...grpc.CallOption) (*DeleteProfileRes, error)
+	ListProfiles(ctx context.Context, in *ListProfilesReq, opts ...grpc.CallOption) (*ListProfilesRes, error)
+	// Streams every matching profile, in order, from a snapshot taken when the call starts.
+	StreamProfiles(ctx context.Context, in *StreamProfilesReq, opts ...grpc.CallOption) (ProfileService_StreamProfilesClient, error)
+	// Streams an event for every change to a profile from now, or from just after resume_revision.
+	WatchProfiles(ctx context.Context, in *WatchProfilesReq, opts ...grpc.CallOption) (ProfileService_WatchProfilesClient, error)
+	// Lists every version of a profile, newest first, until it is purged.
+	ListProfileVersions(ctx context.Context, in *ListProfileVersionsReq, opts ...grpc.CallOption) (*ListProfileVersionsRes, error)
+	// Batch RPCs perform up to 1000 of the corresponding single profile RPCs, returning a result for
+	// each in request order. Batches applied all or nothing share a single revision.
+	BatchCreateProfiles(ctx context.Context, in *BatchCreateProfilesReq, opts ...grpc.CallOption) (*BatchProfilesRes, error)
+	BatchUpdateProfiles(ctx context.Context, in *BatchUpdateProfilesReq, opts ...grpc.CallOption) (*BatchProfilesRes, error)
+	BatchDeleteProfiles(ctx context.Context, in *BatchDeleteProfilesReq, opts ...grpc.CallOption) (*BatchProfilesRes, error)
+	// Creates a profile for each record the client streams, streaming back progress every 100
+	// records and once the client closes its side. Imports are identified by import_id so that one
+	// interrupted by a dropped connection can be resumed from its next_sequence on a new stream.
+	ImportProfiles(ctx context.Context, opts ...grpc.CallOption) (ProfileService_ImportProfilesClient, error)
+	// Searches the names of profiles that aren't deleted, returning the most relevant first.
+	SearchProfiles(ctx context.Context, in *SearchProfilesReq, opts ...grpc.CallOption) (*SearchProfilesRes, error)
+	// Lists clusters of profiles that aren't deleted and have the same normalized first and last
+	// name, and so may be duplicates of each other.
+	FindDuplicates(ctx context.Context, in *FindDuplicatesReq, opts ...grpc.CallOption) (*FindDuplicatesRes, error)
+	// Merges one profile into another, the survivor, and returns the survivor. Fields the survivor
+	// has left empty are filled from the merged profile, and the merged profile's emails, phone
+	// numbers, postal addresses and custom attributes are added to the survivor's. The merged profile
+	// is deleted, leaving a redirect to the survivor that isn't purged with other deleted profiles.
+	MergeProfiles(ctx context.Context, in *MergeProfilesReq, opts ...grpc.CallOption) (*Profile, error)
+}
+
+type profileServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProfileServiceClient(cc *grpc.ClientConn) ProfileServiceClient {
+	return &profileServiceClient{cc}
+}
+
+func (c *profileServiceClient) CreateProfile(ctx context.Context, in *ProfileDto, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/CreateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ReadProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/ReadProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpsertProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/UpsertProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteProfile(ctx context.Context, in *DeleteProfileReq, opts ...grpc.CallOption) (*DeleteProfileRes, error) {
+	out := new(DeleteProfileRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/DeleteProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UndeleteProfile(ctx context.Context, in *ReadProfileReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/UndeleteProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) PurgeProfile(ctx context.Context, in *DeleteProfileReq, opts ...grpc.CallOption) (*DeleteProfileRes, error) {
+	out := new(DeleteProfileRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/PurgeProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListProfiles(ctx context.Context, in *ListProfilesReq, opts ...grpc.CallOption) (*ListProfilesRes, error) {
+	out := new(ListProfilesRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/ListProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) StreamProfiles(ctx context.Context, in *StreamProfilesReq, opts ...grpc.CallOption) (ProfileService_StreamProfilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProfileService_serviceDesc.Streams[0], "/profile.v1.ProfileService/StreamProfiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileServiceStreamProfilesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProfileService_StreamProfilesClient interface {
+	Recv() (*Profile, error)
+	grpc.ClientStream
+}
+
+type profileServiceStreamProfilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileServiceStreamProfilesClient) Recv() (*Profile, error) {
+	m := new(Profile)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *profileServiceClient) WatchProfiles(ctx context.Context, in *WatchProfilesReq, opts ...grpc.CallOption) (ProfileService_WatchProfilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProfileService_serviceDesc.Streams[1], "/profile.v1.ProfileService/WatchProfiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileServiceWatchProfilesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProfileService_WatchProfilesClient interface {
+	Recv() (*ProfileEvent, error)
+	grpc.ClientStream
+}
+
+type profileServiceWatchProfilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileServiceWatchProfilesClient) Recv() (*ProfileEvent, error) {
+	m := new(ProfileEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *profileServiceClient) ListProfileVersions(ctx context.Context, in *ListProfileVersionsReq, opts ...grpc.CallOption) (*ListProfileVersionsRes, error) {
+	out := new(ListProfileVersionsRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/ListProfileVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) BatchCreateProfiles(ctx context.Context, in *BatchCreateProfilesReq, opts ...grpc.CallOption) (*BatchProfilesRes, error) {
+	out := new(BatchProfilesRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/BatchCreateProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) BatchUpdateProfiles(ctx context.Context, in *BatchUpdateProfilesReq, opts ...grpc.CallOption) (*BatchProfilesRes, error) {
+	out := new(BatchProfilesRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/BatchUpdateProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) BatchDeleteProfiles(ctx context.Context, in *BatchDeleteProfilesReq, opts ...grpc.CallOption) (*BatchProfilesRes, error) {
+	out := new(BatchProfilesRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/BatchDeleteProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ImportProfiles(ctx context.Context, opts ...grpc.CallOption) (ProfileService_ImportProfilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProfileService_serviceDesc.Streams[2], "/profile.v1.ProfileService/ImportProfiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileServiceImportProfilesClient{stream}
+	return x, nil
+}
+
+type ProfileService_ImportProfilesClient interface {
+	Send(*ImportProfilesReq) error
+	Recv() (*ImportProfilesRes, error)
+	grpc.ClientStream
+}
+
+type profileServiceImportProfilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileServiceImportProfilesClient) Send(m *ImportProfilesReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *profileServiceImportProfilesClient) Recv() (*ImportProfilesRes, error) {
+	m := new(ImportProfilesRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *profileServiceClient) SearchProfiles(ctx context.Context, in *SearchProfilesReq, opts ...grpc.CallOption) (*SearchProfilesRes, error) {
+	out := new(SearchProfilesRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/SearchProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesReq, opts ...grpc.CallOption) (*FindDuplicatesRes, error) {
+	out := new(FindDuplicatesRes)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/FindDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) MergeProfiles(ctx context.Context, in *MergeProfilesReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/profile.v1.ProfileService/MergeProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServiceServer is the server API for ProfileService service.
+type ProfileServiceServer interface {
+	// Creates a profile. Depending on the server's duplicate mode, profiles with the same normalized
+	// first and last name as an existing one are either allowed, allowed with the ids of the existing
+	// profiles in the duplicate-profile-ids trailer, or rejected with ALREADY_EXISTS.
+	CreateProfile(context.Context, *ProfileDto) (*Profile, error)
+	// Reads a profile. Reading a profile that was merged into another reads the profile it was
+	// merged into.
+	ReadProfile(context.Context, *ReadProfileReq) (*Profile, error)
+	UpdateProfile(context.Context, *UpdateProfileReq) (*Profile, error)
+	// Replaces the profile with the given id, or creates it with that id if there is none. Soft
+	// deleted profiles must be undeleted or purged first.
+	UpsertProfile(context.Context, *UpdateProfileReq) (*Profile, error)
+	// Soft deletes a profile, leaving a tombstone that can be undeleted until it is purged.
+	DeleteProfile(context.Context, *DeleteProfileReq) (*DeleteProfileRes, error)
+	UndeleteProfile(context.Context, *ReadProfileReq) (*Profile, error)
+	// Permanently removes a profile, whether or not it has been soft deleted.
+	PurgeProfile(context.Context, *DeleteProfileReq) (*DeleteProfileRes, error)
+	ListProfiles(context.Context, *ListProfilesReq) (*ListProfilesRes, error)
+	// Streams every matching profile, in order, from a snapshot taken when the call starts.
+	StreamProfiles(*StreamProfilesReq, ProfileService_StreamProfilesServer) error
+	// Streams an event for every change to a profile from now, or from just after resume_revision.
+	WatchProfiles(*WatchProfilesReq, ProfileService_WatchProfilesServer) error
+	// Lists every version of a profile, newest first, until it is purged.
+	ListProfileVersions(context.Context, *ListProfileVersionsReq) (*ListProfileVersionsRes, error)
+	// Batch RPCs perform up to 1000 of the corresponding single profile RPCs, returning a result for
+	// each in request order. Batches applied all or nothing share a single revision.
+	BatchCreateProfiles(context.Context, *BatchCreateProfilesReq) (*BatchProfilesRes, error)
+	BatchUpdateProfiles(context.Context, *BatchUpdateProfilesReq) (*BatchProfilesRes, error)
+	BatchDeleteProfiles(context.Context, *BatchDeleteProfilesReq) (*BatchProfilesRes, error)
+	// Creates a profile for each record the client streams, streaming back progress every 100
+	// records and once the client closes its side. Imports are identified by import_id so that one
+	// interrupted by a dropped connection can be resumed from its next_sequence on a new stream.
+	ImportProfiles(ProfileService_ImportProfilesServer) error
+	// Searches the names of profiles that aren't deleted, returning the most relevant first.
+	SearchProfiles(context.Context, *SearchProfilesReq) (*SearchProfilesRes, error)
+	// Lists clusters of profiles that aren't deleted and have the same normalized first and last
+	// name, and so may be duplicates of each other.
+	FindDuplicates(context.Context, *FindDuplicatesReq) (*FindDuplicatesRes, error)
+	// Merges one profile into another, the survivor, and returns the survivor. Fields the survivor
+	// has left empty are filled from the merged profile, and the merged profile's emails, phone
+	// numbers, postal addresses and custom attributes are added to the survivor's. The merged profile
+	// is deleted, leaving a redirect to the survivor that isn't purged with other deleted profiles.
+	MergeProfiles(context.Context, *MergeProfilesReq) (*Profile, error)
+}
+
+// UnimplementedProfileServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProfileServiceServer struct {
+}
+
+func (*UnimplementedProfileServiceServer) CreateProfile(ctx context.Context, req *ProfileDto) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) ReadProfile(ctx context.Context, req *ReadProfileReq) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpdateProfile(ctx context.Context, req *UpdateProfileReq) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpsertProfile(ctx context.Context, req *UpdateProfileReq) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteProfile(ctx context.Context, req *DeleteProfileReq) (*DeleteProfileRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) UndeleteProfile(ctx context.Context, req *ReadProfileReq) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) PurgeProfile(ctx context.Context, req *DeleteProfileReq) (*DeleteProfileRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) ListProfiles(ctx context.Context, req *ListProfilesReq) (*ListProfilesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) StreamProfiles(req *StreamProfilesReq, srv ProfileService_StreamProfilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) WatchProfiles(req *WatchProfilesReq, srv ProfileService_WatchProfilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) ListProfileVersions(ctx context.Context, req *ListProfileVersionsReq) (*ListProfileVersionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfileVersions not implemented")
+}
+func (*UnimplementedProfileServiceServer) BatchCreateProfiles(ctx context.Context, req *BatchCreateProfilesReq) (*BatchProfilesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) BatchUpdateProfiles(ctx context.Context, req *BatchUpdateProfilesReq) (*BatchProfilesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) BatchDeleteProfiles(ctx context.Context, req *BatchDeleteProfilesReq) (*BatchProfilesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) ImportProfiles(srv ProfileService_ImportProfilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) SearchProfiles(ctx context.Context, req *SearchProfilesReq) (*SearchProfilesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProfiles not implemented")
+}
+func (*UnimplementedProfileServiceServer) FindDuplicates(ctx context.Context, req *FindDuplicatesReq) (*FindDuplicatesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (*UnimplementedProfileServiceServer) MergeProfiles(ctx context.Context, req *MergeProfilesReq) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeProfiles not implemented")
+}
+
+func RegisterProfileServiceServer(s *grpc.Server, srv ProfileServiceServer) {
+	s.RegisterService(&_ProfileService_serviceDesc, srv)
+}
+
+func _ProfileService_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileDto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/CreateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateProfile(ctx, req.(*ProfileDto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ReadProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ReadProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/ReadProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ReadProfile(ctx, req.(*ReadProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpsertProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpsertProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/UpsertProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpsertProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/DeleteProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteProfile(ctx, req.(*DeleteProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UndeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UndeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/UndeleteProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UndeleteProfile(ctx, req.(*ReadProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_PurgeProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).PurgeProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/PurgeProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).PurgeProfile(ctx, req.(*DeleteProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/ListProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListProfiles(ctx, req.(*ListProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_StreamProfiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProfilesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileServiceServer).StreamProfiles(m, &profileServiceStreamProfilesServer{stream})
+}
+
+type ProfileService_StreamProfilesServer interface {
+	Send(*Profile) error
+	grpc.ServerStream
+}
+
+type profileServiceStreamProfilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileServiceStreamProfilesServer) Send(m *Profile) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProfileService_WatchProfiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProfilesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileServiceServer).WatchProfiles(m, &profileServiceWatchProfilesServer{stream})
+}
+
+type ProfileService_WatchProfilesServer interface {
+	Send(*ProfileEvent) error
+	grpc.ServerStream
+}
+
+type profileServiceWatchProfilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileServiceWatchProfilesServer) Send(m *ProfileEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProfileService_ListProfileVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListProfileVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/ListProfileVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListProfileVersions(ctx, req.(*ListProfileVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_BatchCreateProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).BatchCreateProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/BatchCreateProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).BatchCreateProfiles(ctx, req.(*BatchCreateProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_BatchUpdateProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).BatchUpdateProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/BatchUpdateProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).BatchUpdateProfiles(ctx, req.(*BatchUpdateProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_BatchDeleteProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).BatchDeleteProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/BatchDeleteProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).BatchDeleteProfiles(ctx, req.(*BatchDeleteProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ImportProfiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProfileServiceServer).ImportProfiles(&profileServiceImportProfilesServer{stream})
+}
+
+type ProfileService_ImportProfilesServer interface {
+	Send(*ImportProfilesRes) error
+	Recv() (*ImportProfilesReq, error)
+	grpc.ServerStream
+}
+
+type profileServiceImportProfilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileServiceImportProfilesServer) Send(m *ImportProfilesRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *profileServiceImportProfilesServer) Recv() (*ImportProfilesReq, error) {
+	m := new(ImportProfilesReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ProfileService_SearchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).SearchProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/SearchProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).SearchProfiles(ctx, req.(*SearchProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/FindDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_MergeProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).MergeProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.v1.ProfileService/MergeProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).MergeProfiles(ctx, req.(*MergeProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProfileService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "profile.v1.ProfileService",
+	HandlerType: (*ProfileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProfile",
+			Handler:    _ProfileService_CreateProfile_Handler,
+		},
+		{
+			MethodName: "ReadProfile",
+			Handler:    _ProfileService_ReadProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _ProfileService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "UpsertProfile",
+			Handler:    _ProfileService_UpsertProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _ProfileService_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "UndeleteProfile",
+			Handler:    _ProfileService_UndeleteProfile_Handler,
+		},
+		{
+			MethodName: "PurgeProfile",
+			Handler:    _ProfileService_PurgeProfile_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _ProfileService_ListProfiles_Handler,
+		},
+		{
+			MethodName: "ListProfileVersions",
+			Handler:    _ProfileService_ListProfileVersions_Handler,
+		},
+		{
+			MethodName: "BatchCreateProfiles",
+			Handler:    _ProfileService_BatchCreateProfiles_Handler,
+		},
+		{
+			MethodName: "BatchUpdateProfiles",
+			Handler:    _ProfileService_BatchUpdateProfiles_Handler,
+		},
+		{
+			MethodName: "BatchDeleteProfiles",
+			Handler:    _ProfileService_BatchDeleteProfiles_Handler,
+		},
+		{
+			MethodName: "SearchProfiles",
+			Handler:    _ProfileService_SearchProfiles_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _ProfileService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeProfiles",
+			Handler:    _ProfileService_MergeProfiles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProfiles",
+			Handler:       _ProfileService_StreamProfiles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchProfiles",
+			Handler:       _ProfileService_WatchProfiles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportProfiles",
+			Handler:       _ProfileService_ImportProfiles_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/v1/profile.proto",
+}
+
+func (m *Profile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Profile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.MergedInto) > 0 {
+		i -= len(m.MergedInto)
+		copy(dAtA[i:], m.MergedInto)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.MergedInto)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CustomAttributes) > 0 {
+		for k := range m.CustomAttributes {
+			v := m.CustomAttributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintProfile(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintProfile(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProfile(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Locale) > 0 {
+		i -= len(m.Locale)
+		copy(dAtA[i:], m.Locale)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Locale)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.DateOfBirth) > 0 {
+		i -= len(m.DateOfBirth)
+		copy(dAtA[i:], m.DateOfBirth)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.DateOfBirth)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PostalAddresses) > 0 {
+		for iNdEx := len(m.PostalAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostalAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PhoneNumbers) > 0 {
+		for iNdEx := len(m.PhoneNumbers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PhoneNumbers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Emails) > 0 {
+		for iNdEx := len(m.Emails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Revision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DeleteDate != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeleteDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeleteDate):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintProfile(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpdateDate != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateDate):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintProfile(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreateDate != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateDate):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintProfile(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfileDto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileDto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileDto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CustomAttributes) > 0 {
+		for k := range m.CustomAttributes {
+			v := m.CustomAttributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintProfile(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintProfile(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProfile(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Locale) > 0 {
+		i -= len(m.Locale)
+		copy(dAtA[i:], m.Locale)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Locale)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DateOfBirth) > 0 {
+		i -= len(m.DateOfBirth)
+		copy(dAtA[i:], m.DateOfBirth)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.DateOfBirth)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PostalAddresses) > 0 {
+		for iNdEx := len(m.PostalAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostalAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PhoneNumbers) > 0 {
+		for iNdEx := len(m.PhoneNumbers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PhoneNumbers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Emails) > 0 {
+		for iNdEx := len(m.Emails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmailAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmailAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmailAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PhoneNumber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhoneNumber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhoneNumber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostalAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostalAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostalAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RegionCode) > 0 {
+		i -= len(m.RegionCode)
+		copy(dAtA[i:], m.RegionCode)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.RegionCode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AdministrativeArea) > 0 {
+		i -= len(m.AdministrativeArea)
+		copy(dAtA[i:], m.AdministrativeArea)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.AdministrativeArea)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Locality) > 0 {
+		i -= len(m.Locality)
+		copy(dAtA[i:], m.Locality)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Locality)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddressLines) > 0 {
+		for iNdEx := len(m.AddressLines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressLines[iNdEx])
+			copy(dAtA[i:], m.AddressLines[iNdEx])
+			i = encodeVarintProfile(dAtA, i, uint64(len(m.AddressLines[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadProfileReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadProfileReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadProfileReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AsOf != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AsOf, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AsOf):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintProfile(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShowDeleted {
+		i--
+		if m.ShowDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateProfileReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateProfileReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateProfileReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpectedRevision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.ExpectedRevision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProfileReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProfileReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProfileReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpectedRevision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.ExpectedRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProfileRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProfileRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProfileRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ShowDeleted {
+		i--
+		if m.ShowDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ShowDeleted {
+		i--
+		if m.ShowDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ResumeRevision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.ResumeRevision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfileEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Revision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProfilesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProfilesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProfilesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProfileVersionsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProfileVersionsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProfileVersionsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProfileVersionsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProfileVersionsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProfileVersionsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Mode != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchUpdateProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchUpdateProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchUpdateProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Mode != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchDeleteProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchDeleteProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchDeleteProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Mode != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchProfilesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchProfilesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchProfilesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchProfileResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchProfileResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchProfileResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ImportId) > 0 {
+		i -= len(m.ImportId)
+		copy(dAtA[i:], m.ImportId)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.ImportId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportProfilesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportProfilesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportProfilesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Rejected != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Rejected))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Accepted != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Accepted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ImportId) > 0 {
+		i -= len(m.ImportId)
+		copy(dAtA[i:], m.ImportId)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.ImportId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRecordError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRecordError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRecordError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DisableFuzzy {
+		i--
+		if m.DisableFuzzy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchProfilesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProfilesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProfilesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProfile(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindDuplicatesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindDuplicatesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindDuplicatesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindDuplicatesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindDuplicatesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindDuplicatesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DuplicateCluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateCluster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateCluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeProfilesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeProfilesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeProfilesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpectedMergedRevision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.ExpectedMergedRevision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpectedSurvivorRevision != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.ExpectedSurvivorRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MergedId) > 0 {
+		i -= len(m.MergedId)
+		copy(dAtA[i:], m.MergedId)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.MergedId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SurvivorId) > 0 {
+		i -= len(m.SurvivorId)
+		copy(dAtA[i:], m.SurvivorId)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.SurvivorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProfile(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProfile(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Profile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.CreateDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateDate)
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.UpdateDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateDate)
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.DeleteDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeleteDate)
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovProfile(uint64(m.Revision))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if len(m.Emails) > 0 {
+		for _, e := range m.Emails {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if len(m.PhoneNumbers) > 0 {
+		for _, e := range m.PhoneNumbers {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if len(m.PostalAddresses) > 0 {
+		for _, e := range m.PostalAddresses {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.DateOfBirth)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Locale)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if len(m.CustomAttributes) > 0 {
+		for k, v := range m.CustomAttributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProfile(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProfile(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProfile(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.MergedInto)
+	if l > 0 {
+		n += 2 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 2 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfileDto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if len(m.Emails) > 0 {
+		for _, e := range m.Emails {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if len(m.PhoneNumbers) > 0 {
+		for _, e := range m.PhoneNumbers {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if len(m.PostalAddresses) > 0 {
+		for _, e := range m.PostalAddresses {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.DateOfBirth)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Locale)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if len(m.CustomAttributes) > 0 {
+		for k, v := range m.CustomAttributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProfile(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProfile(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProfile(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmailAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PhoneNumber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostalAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressLines) > 0 {
+		for _, s := range m.AddressLines {
+			l = len(s)
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.Locality)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.AdministrativeArea)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.RegionCode)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadProfileReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.ShowDeleted {
+		n += 2
+	}
+	if m.AsOf != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AsOf)
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateProfileReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.ExpectedRevision != 0 {
+		n += 1 + sovProfile(uint64(m.ExpectedRevision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteProfileReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.ExpectedRevision != 0 {
+		n += 1 + sovProfile(uint64(m.ExpectedRevision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteProfileRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		n += 1 + sovProfile(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.ShowDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.ShowDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResumeRevision != 0 {
+		n += 1 + sovProfile(uint64(m.ResumeRevision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfileEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovProfile(uint64(m.Type))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovProfile(uint64(m.Revision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProfilesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovProfile(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProfileVersionsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovProfile(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProfileVersionsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCreateProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovProfile(uint64(m.Mode))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchUpdateProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovProfile(uint64(m.Mode))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchDeleteProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovProfile(uint64(m.Mode))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchProfilesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchProfileResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ImportId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProfile(uint64(m.Sequence))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportProfilesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ImportId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovProfile(uint64(m.NextSequence))
+	}
+	if m.Accepted != 0 {
+		n += 1 + sovProfile(uint64(m.Accepted))
+	}
+	if m.Rejected != 0 {
+		n += 1 + sovProfile(uint64(m.Rejected))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportRecordError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovProfile(uint64(m.Sequence))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovProfile(uint64(m.PageSize))
+	}
+	if m.DisableFuzzy {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchProfilesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindDuplicatesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		n += 1 + sovProfile(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindDuplicatesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DuplicateCluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeProfilesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SurvivorId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	l = len(m.MergedId)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
+	if m.ExpectedSurvivorRevision != 0 {
+		n += 1 + sovProfile(uint64(m.ExpectedSurvivorRevision))
+	}
+	if m.ExpectedMergedRevision != 0 {
+		n += 1 + sovProfile(uint64(m.ExpectedMergedRevision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovProfile(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProfile(x uint64) (n int) {
+	return sovProfile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Profile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateDate == nil {
+				m.CreateDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateDate == nil {
+				m.UpdateDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdateDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteDate == nil {
+				m.DeleteDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeleteDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emails = append(m.Emails, &EmailAddress{})
+			if err := m.Emails[len(m.Emails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumbers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumbers = append(m.PhoneNumbers, &PhoneNumber{})
+			if err := m.PhoneNumbers[len(m.PhoneNumbers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalAddresses = append(m.PostalAddresses, &PostalAddress{})
+			if err := m.PostalAddresses[len(m.PostalAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateOfBirth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateOfBirth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomAttributes == nil {
+				m.CustomAttributes = make(map[string]*types.Value)
+			}
+			var mapkey string
+			var mapvalue *types.Value
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProfile
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProfile
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProfile
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProfile
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProfile
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProfile
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProfile
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Value{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProfile(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProfile
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedInto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergedInto = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileDto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileDto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileDto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emails = append(m.Emails, &EmailAddress{})
+			if err := m.Emails[len(m.Emails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumbers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumbers = append(m.PhoneNumbers, &PhoneNumber{})
+			if err := m.PhoneNumbers[len(m.PhoneNumbers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalAddresses = append(m.PostalAddresses, &PostalAddress{})
+			if err := m.PostalAddresses[len(m.PostalAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateOfBirth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateOfBirth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomAttributes == nil {
+				m.CustomAttributes = make(map[string]*types.Value)
+			}
+			var mapkey string
+			var mapvalue *types.Value
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProfile
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProfile
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProfile
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProfile
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProfile
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProfile
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProfile
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Value{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProfile(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProfile
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmailAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmailAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmailAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhoneNumber) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhoneNumber: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhoneNumber: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostalAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostalAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostalAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressLines = append(m.AddressLines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locality = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdministrativeArea", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdministrativeArea = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadProfileReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadProfileReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadProfileReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShowDeleted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AsOf == nil {
+				m.AsOf = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AsOf, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateProfileReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateProfileReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateProfileReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &ProfileDto{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRevision", wireType)
+			}
+			m.ExpectedRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProfileReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProfileReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProfileReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRevision", wireType)
+			}
+			m.ExpectedRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProfileRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProfileRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProfileRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShowDeleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShowDeleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeRevision", wireType)
+			}
+			m.ResumeRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ProfileEvent_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &Profile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProfilesRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProfilesRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProfilesRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, &Profile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProfileVersionsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProfileVersionsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProfileVersionsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProfileVersionsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProfileVersionsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProfileVersionsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &Profile{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCreateProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, &ProfileDto{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchUpdateProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchUpdateProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchUpdateProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &UpdateProfileReq{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchDeleteProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchDeleteProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchDeleteProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &DeleteProfileReq{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchProfilesRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchProfilesRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchProfilesRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BatchProfileResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchProfileResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchProfileResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchProfileResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &rpc.Status{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &Profile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImportId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &ProfileDto{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportProfilesRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportProfilesRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportProfilesRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImportId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			m.Accepted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accepted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			m.Rejected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rejected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ImportRecordError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ListProfilesRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Profile != nil {
-		l = m.Profile.Size()
-		n += 1 + l + sovProfile(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovProfile(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProfile(x uint64) (n int) {
-	return sovProfile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Profile) Unmarshal(dAtA []byte) error {
+func (m *ImportRecordError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1058,17 +9725,36 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportRecordError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportRecordError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProfile
@@ -1078,27 +9764,82 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProfile
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProfile
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Status == nil {
+				m.Status = &rpc.Status{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchProfilesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchProfilesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1126,13 +9867,32 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFuzzy", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProfile
@@ -1142,27 +9902,66 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProfile
+			m.DisableFuzzy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProfile
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchProfilesRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchProfilesRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchProfilesRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1189,16 +9988,65 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateDate == nil {
-				m.CreateDate = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateDate, dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &SearchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1225,13 +10073,24 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateDate == nil {
-				m.UpdateDate = new(time.Time)
+			if m.Profile == nil {
+				m.Profile = &Profile{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdateDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProfile(dAtA[iNdEx:])
@@ -1254,7 +10113,7 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProfileDto) Unmarshal(dAtA []byte) error {
+func (m *FindDuplicatesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1277,17 +10136,17 @@ func (m *ProfileDto) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProfileDto: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindDuplicatesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProfileDto: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindDuplicatesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var stringLen uint64
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProfile
@@ -1297,27 +10156,14 @@ func (m *ProfileDto) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProfile
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProfile
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1345,7 +10191,7 @@ func (m *ProfileDto) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1369,7 +10215,7 @@ func (m *ProfileDto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadProfileReq) Unmarshal(dAtA []byte) error {
+func (m *FindDuplicatesRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,15 +10238,49 @@ func (m *ReadProfileReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadProfileReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindDuplicatesRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadProfileReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindDuplicatesRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &DuplicateCluster{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1428,7 +10308,7 @@ func (m *ReadProfileReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1452,7 +10332,7 @@ func (m *ReadProfileReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateProfileReq) Unmarshal(dAtA []byte) error {
+func (m *DuplicateCluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,15 +10355,15 @@ func (m *UpdateProfileReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateProfileReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicateCluster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateProfileReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicateCluster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,11 +10391,11 @@ func (m *UpdateProfileReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1542,10 +10422,8 @@ func (m *UpdateProfileReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profile == nil {
-				m.Profile = &ProfileDto{}
-			}
-			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Profiles = append(m.Profiles, &Profile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1571,7 +10449,7 @@ func (m *UpdateProfileReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteProfileRes) Unmarshal(dAtA []byte) error {
+func (m *MergeProfilesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1594,17 +10472,17 @@ func (m *DeleteProfileRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteProfileRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeProfilesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteProfileRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeProfilesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurvivorId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProfile
@@ -1614,68 +10492,29 @@ func (m *DeleteProfileRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProfile(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProfile
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListProfilesRes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProfile
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListProfilesRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProfilesRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SurvivorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProfile
@@ -1685,28 +10524,62 @@ func (m *ListProfilesRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProfile
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProfile
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profile == nil {
-				m.Profile = &Profile{}
+			m.MergedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedSurvivorRevision", wireType)
 			}
-			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExpectedSurvivorRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedSurvivorRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedMergedRevision", wireType)
+			}
+			m.ExpectedMergedRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedMergedRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProfile(dAtA[iNdEx:])
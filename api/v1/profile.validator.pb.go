@@ -7,11 +7,13 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/gogo/protobuf/proto"
-	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "github.com/mwitkow/go-proto-validators"
+	_ "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "github.com/gogo/googleapis/google/rpc"
 	_ "github.com/gogo/protobuf/gogoproto"
-	_ "github.com/mwitkow/go-proto-validators"
 	time "time"
+	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
@@ -32,8 +34,40 @@ func (this *Profile) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("UpdateDate", err)
 		}
 	}
+	if this.DeleteDate != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DeleteDate); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DeleteDate", err)
+		}
+	}
+	for _, item := range this.Emails {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Emails", err)
+			}
+		}
+	}
+	for _, item := range this.PhoneNumbers {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("PhoneNumbers", err)
+			}
+		}
+	}
+	for _, item := range this.PostalAddresses {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("PostalAddresses", err)
+			}
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
+
+var _regex_ProfileDto_Id = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._~-]{0,127})?$`)
+var _regex_ProfileDto_DateOfBirth = regexp.MustCompile(`^([0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01]))?$`)
+var _regex_ProfileDto_Locale = regexp.MustCompile(`^([A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*)?$`)
+
 func (this *ProfileDto) Validate() error {
 	if this.FirstName == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("FirstName", fmt.Errorf(`must not be empty`))
@@ -41,23 +75,240 @@ func (this *ProfileDto) Validate() error {
 	if this.LastName == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("LastName", fmt.Errorf(`must not be empty`))
 	}
+	if !(len(this.IdempotencyKey) < 256) {
+		return github_com_mwitkow_go_proto_validators.FieldError("IdempotencyKey", fmt.Errorf(`must be shorter than 256 characters`))
+	}
+	if !_regex_ProfileDto_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`must be at most 128 letters, digits, '.', '_', '~' or '-', starting with a letter or digit`))
+	}
+	if !(len(this.DisplayName) < 256) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DisplayName", fmt.Errorf(`must be shorter than 256 characters`))
+	}
+	if len(this.Emails) > 10 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Emails", fmt.Errorf(`must have at most 10 entries`))
+	}
+	for _, item := range this.Emails {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Emails", err)
+			}
+		}
+	}
+	if len(this.PhoneNumbers) > 10 {
+		return github_com_mwitkow_go_proto_validators.FieldError("PhoneNumbers", fmt.Errorf(`must have at most 10 entries`))
+	}
+	for _, item := range this.PhoneNumbers {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("PhoneNumbers", err)
+			}
+		}
+	}
+	if len(this.PostalAddresses) > 5 {
+		return github_com_mwitkow_go_proto_validators.FieldError("PostalAddresses", fmt.Errorf(`must have at most 5 entries`))
+	}
+	for _, item := range this.PostalAddresses {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("PostalAddresses", err)
+			}
+		}
+	}
+	if !_regex_ProfileDto_DateOfBirth.MatchString(this.DateOfBirth) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DateOfBirth", fmt.Errorf(`must be a date in the form YYYY-MM-DD`))
+	}
+	if !_regex_ProfileDto_Locale.MatchString(this.Locale) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Locale", fmt.Errorf(`must be a BCP 47 language tag such as en-US`))
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_EmailAddress_Address = regexp.MustCompile(`^[^@ ]+@[^@ ]+[.][^@ ]+$`)
+
+func (this *EmailAddress) Validate() error {
+	if !_regex_EmailAddress_Address.MatchString(this.Address) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`must be an email address`))
+	}
+	if !(len(this.Address) < 255) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`must be an email address`))
+	}
+	if !(len(this.Label) < 64) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Label", fmt.Errorf(`must be shorter than 64 characters`))
+	}
+	return nil
+}
+
+var _regex_PhoneNumber_Number = regexp.MustCompile(`^[+][1-9][0-9]{1,14}$`)
+
+func (this *PhoneNumber) Validate() error {
+	if !_regex_PhoneNumber_Number.MatchString(this.Number) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Number", fmt.Errorf(`must be an E.164 phone number`))
+	}
+	if !(len(this.Label) < 64) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Label", fmt.Errorf(`must be shorter than 64 characters`))
+	}
+	return nil
+}
+
+var _regex_PostalAddress_RegionCode = regexp.MustCompile(`^[A-Z]{2}$`)
+
+func (this *PostalAddress) Validate() error {
+	if len(this.AddressLines) > 5 {
+		return github_com_mwitkow_go_proto_validators.FieldError("AddressLines", fmt.Errorf(`must have at most 5 lines, each shorter than 256 characters`))
+	}
+	for _, item := range this.AddressLines {
+		if !(len(item) < 256) {
+			return github_com_mwitkow_go_proto_validators.FieldError("AddressLines", fmt.Errorf(`must have at most 5 lines, each shorter than 256 characters`))
+		}
+	}
+	if !(len(this.Locality) < 128) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Locality", fmt.Errorf(`must be shorter than 128 characters`))
+	}
+	if !(len(this.AdministrativeArea) < 128) {
+		return github_com_mwitkow_go_proto_validators.FieldError("AdministrativeArea", fmt.Errorf(`must be shorter than 128 characters`))
+	}
+	if !(len(this.PostalCode) < 32) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PostalCode", fmt.Errorf(`must be shorter than 32 characters`))
+	}
+	if !_regex_PostalAddress_RegionCode.MatchString(this.RegionCode) {
+		return github_com_mwitkow_go_proto_validators.FieldError("RegionCode", fmt.Errorf(`must be an ISO 3166-1 alpha-2 country code`))
+	}
+	if !(len(this.Label) < 64) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Label", fmt.Errorf(`must be shorter than 64 characters`))
+	}
 	return nil
 }
 func (this *ReadProfileReq) Validate() error {
+	if this.AsOf != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.AsOf); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("AsOf", err)
+		}
+	}
 	return nil
 }
 func (this *UpdateProfileReq) Validate() error {
+	if nil == this.Profile {
+		return github_com_mwitkow_go_proto_validators.FieldError("Profile", fmt.Errorf("message must exist"))
+	}
 	if this.Profile != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Profile); err != nil {
 			return github_com_mwitkow_go_proto_validators.FieldError("Profile", err)
 		}
 	}
+	if this.UpdateMask != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.UpdateMask); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("UpdateMask", err)
+		}
+	}
+	return nil
+}
+func (this *DeleteProfileReq) Validate() error {
 	return nil
 }
 func (this *DeleteProfileRes) Validate() error {
 	return nil
 }
+func (this *ListProfilesReq) Validate() error {
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`must not be negative`))
+	}
+	return nil
+}
+func (this *StreamProfilesReq) Validate() error {
+	return nil
+}
+func (this *WatchProfilesReq) Validate() error {
+	return nil
+}
+func (this *ProfileEvent) Validate() error {
+	if this.Profile != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Profile); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Profile", err)
+		}
+	}
+	return nil
+}
 func (this *ListProfilesRes) Validate() error {
+	for _, item := range this.Profiles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Profiles", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListProfileVersionsReq) Validate() error {
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`must not be negative`))
+	}
+	return nil
+}
+func (this *ListProfileVersionsRes) Validate() error {
+	for _, item := range this.Versions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Versions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BatchCreateProfilesReq) Validate() error {
+	for _, item := range this.Profiles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Profiles", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BatchUpdateProfilesReq) Validate() error {
+	for _, item := range this.Requests {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Requests", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BatchDeleteProfilesReq) Validate() error {
+	for _, item := range this.Requests {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Requests", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BatchProfilesRes) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BatchProfileResult) Validate() error {
+	if this.Status != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Status); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Status", err)
+		}
+	}
+	if this.Profile != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Profile); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Profile", err)
+		}
+	}
+	return nil
+}
+func (this *ImportProfilesReq) Validate() error {
 	if this.Profile != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Profile); err != nil {
 			return github_com_mwitkow_go_proto_validators.FieldError("Profile", err)
@@ -65,3 +316,86 @@ func (this *ListProfilesRes) Validate() error {
 	}
 	return nil
 }
+func (this *ImportProfilesRes) Validate() error {
+	for _, item := range this.Errors {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Errors", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ImportRecordError) Validate() error {
+	if this.Status != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Status); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Status", err)
+		}
+	}
+	return nil
+}
+func (this *SearchProfilesReq) Validate() error {
+	if this.Query == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Query", fmt.Errorf(`value '%v' must not be an empty string`, this.Query))
+	}
+	if !(len(this.Query) < 256) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Query", fmt.Errorf(`value '%v' must have a length smaller than '256'`, this.Query))
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`must not be negative`))
+	}
+	return nil
+}
+func (this *SearchProfilesRes) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SearchResult) Validate() error {
+	if this.Profile != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Profile); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Profile", err)
+		}
+	}
+	return nil
+}
+func (this *FindDuplicatesReq) Validate() error {
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`must not be negative`))
+	}
+	return nil
+}
+func (this *FindDuplicatesRes) Validate() error {
+	for _, item := range this.Clusters {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Clusters", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DuplicateCluster) Validate() error {
+	for _, item := range this.Profiles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Profiles", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *MergeProfilesReq) Validate() error {
+	if this.SurvivorId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SurvivorId", fmt.Errorf(`value '%v' must not be an empty string`, this.SurvivorId))
+	}
+	if this.MergedId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("MergedId", fmt.Errorf(`value '%v' must not be an empty string`, this.MergedId))
+	}
+	return nil
+}
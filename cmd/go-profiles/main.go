@@ -2,13 +2,17 @@ package main
 
 import (
 	"github.com/joshjon/go-profiles/internal/agent"
+	"github.com/joshjon/go-profiles/internal/auth"
 	"github.com/joshjon/go-profiles/internal/config"
+	"github.com/joshjon/go-profiles/internal/server"
+	"github.com/joshjon/go-profiles/internal/wal"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 type cfg struct {
@@ -48,9 +52,28 @@ func setupFlags(cmd *cobra.Command) error {
 	cmd.Flags().String("node-name", hostname, "Unique server ID.")
 	cmd.Flags().Int("rpc-port", 8400, "Port for RPC clients connections.")
 
+	cmd.Flags().String("data-dir", "", "Directory to persist profiles in. Profiles are kept in memory only if unset.")
+	cmd.Flags().String("fsync", "always", "When to fsync the data dir: always, interval or never.")
+	cmd.Flags().Duration("fsync-interval", time.Second, "How often to fsync the data dir when --fsync=interval.")
+	cmd.Flags().Int64("segment-max-bytes", 16<<20, "Size at which a write-ahead log segment is sealed and a new one started.")
+	cmd.Flags().Duration("compact-interval", 10*time.Minute, "How often to compact the write-ahead log into a snapshot. Disabled if 0.")
+	cmd.Flags().Duration("tombstone-retention", 30*24*time.Hour, "How long deleted profiles can be undeleted before they're purged. Kept forever if 0.")
+	cmd.Flags().Int("watch-history", 1000, "Number of recent profile events retained for watchers to resume from.")
+	cmd.Flags().Duration("idempotency-window", 24*time.Hour, "How long CreateProfile idempotency keys are remembered for.")
+
+	cmd.Flags().String("validation-rules-file", "", "Path to YAML validation rules profiles must satisfy.")
+	cmd.Flags().String("duplicate-mode", "allow", "How profiles with the same name as existing ones are created: allow, warn or reject.")
+
 	cmd.Flags().String("acl-model-file", "", "Path to ACL model.")
 	cmd.Flags().String("acl-policy-file", "", "Path to ACL policy.")
 
+	cmd.Flags().String("jwt-hmac-secret-file", "", "Path to a secret that verifies HS256 bearer tokens.")
+	cmd.Flags().String("jwt-public-key-file", "", "Path to a PEM RSA or P-256 ECDSA public key that verifies RS256 or ES256 bearer tokens.")
+	cmd.Flags().String("jwt-jwks-file", "", "Path to a JSON Web Key Set that verifies bearer tokens.")
+	cmd.Flags().String("jwt-subject-claim", "sub", "Bearer token claim holding the subject clients authenticate as, which policies name prefixed with jwt:.")
+	cmd.Flags().String("jwt-issuer", "", "Issuer bearer tokens must have, if any.")
+	cmd.Flags().String("jwt-audience", "", "Audience bearer tokens must have, if any.")
+
 	cmd.Flags().String("server-tls-cert-file", "", "Path to server tls cert.")
 	cmd.Flags().String("server-tls-key-file", "", "Path to server tls key.")
 	cmd.Flags().String("server-tls-ca-file", "", "Path to server certificate authority.")
@@ -75,8 +98,27 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 
 	c.cfg.NodeName = viper.GetString("node-name")
 	c.cfg.RPCPort = viper.GetInt("rpc-port")
+	c.cfg.DataDir = viper.GetString("data-dir")
+	c.cfg.StoreSyncPolicy, err = wal.ParseSyncPolicy(viper.GetString("fsync"))
+	if err != nil {
+		return err
+	}
+	c.cfg.StoreSyncInterval = viper.GetDuration("fsync-interval")
+	c.cfg.StoreSegmentBytes = viper.GetInt64("segment-max-bytes")
+	c.cfg.StoreCompactInterval = viper.GetDuration("compact-interval")
+	c.cfg.TombstoneRetention = viper.GetDuration("tombstone-retention")
+	c.cfg.WatchHistory = viper.GetInt("watch-history")
+	c.cfg.IdempotencyWindow = viper.GetDuration("idempotency-window")
+	c.cfg.ValidationRulesFile = viper.GetString("validation-rules-file")
+	c.cfg.DuplicateMode, err = server.ParseDuplicateMode(viper.GetString("duplicate-mode"))
+	if err != nil {
+		return err
+	}
 	c.cfg.ACLModelFile = viper.GetString("acl-model-file")
 	c.cfg.ACLPolicyFile = viper.GetString("acl-policy-file")
+	if err = c.setupJWTConfig(); err != nil {
+		return err
+	}
 	c.cfg.ServerTLSConfig.CertFile = viper.GetString("server-tls-cert-file")
 	c.cfg.ServerTLSConfig.KeyFile = viper.GetString("server-tls-key-file")
 	c.cfg.ServerTLSConfig.CAFile = viper.GetString("server-tls-ca-file")
@@ -93,7 +135,44 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// Creates the agent and shuts down gracefully when the OS terminates the program.
+// Configures bearer token authentication when any keys to verify tokens with are given.
+func (c *cli) setupJWTConfig() error {
+	var keys []auth.JWTKey
+	if file := viper.GetString("jwt-hmac-secret-file"); file != "" {
+		key, err := auth.LoadSecret(file)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	if file := viper.GetString("jwt-public-key-file"); file != "" {
+		key, err := auth.LoadPublicKey(file)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	if file := viper.GetString("jwt-jwks-file"); file != "" {
+		jwks, err := auth.LoadJWKS(file)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, jwks...)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	c.cfg.JWT = &auth.JWTConfig{
+		Keys:         keys,
+		SubjectClaim: viper.GetString("jwt-subject-claim"),
+		Issuer:       viper.GetString("jwt-issuer"),
+		Audience:     viper.GetString("jwt-audience"),
+	}
+	return nil
+}
+
+// Creates the agent and shuts down gracefully when the OS terminates the program. SIGHUP reloads
+// the ACL policy.
 func (c *cli) run(cmd *cobra.Command, args []string) error {
 	agt, err := agent.New(c.cfg.Config)
 	if err != nil {
@@ -101,8 +180,17 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	}
 	log.Println("Serving gRPC on", c.cfg.RPCAddr())
 	sigc := make(chan os.Signal, 1)
-	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
-	<-sigc
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for sig := range sigc {
+		if sig != syscall.SIGHUP {
+			break
+		}
+		if err := agt.ReloadACLPolicy(); err != nil {
+			log.Printf("reload ACL policy, keeping the previous policy: %v", err)
+		} else {
+			log.Println("Reloaded ACL policy")
+		}
+	}
 	agt.Shutdown()
 	return nil
 }
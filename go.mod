@@ -3,7 +3,10 @@ module github.com/joshjon/go-profiles
 go 1.15
 
 require (
+	github.com/Knetic/govaluate v3.0.1-0.20171022003610-9aa49832a739+incompatible
 	github.com/casbin/casbin v1.9.1
+	github.com/fsnotify/fsnotify v1.4.7
+	github.com/gogo/googleapis v1.4.1
 	github.com/gogo/protobuf v1.3.2
 	github.com/google/uuid v1.1.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.2
@@ -12,8 +15,10 @@ require (
 	github.com/spf13/cobra v1.1.1
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.5.1
+	golang.org/x/text v0.3.3
 	google.golang.org/genproto v0.0.0-20210121164019-fc48d45331c7
 	google.golang.org/grpc v1.35.0
 	google.golang.org/grpc/examples v0.0.0-20210122012134-2c42474aca0c // indirect
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.2.8
 )
@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"github.com/joshjon/go-profiles/internal/auth"
 	"github.com/joshjon/go-profiles/internal/server"
+	"github.com/joshjon/go-profiles/internal/store"
+	"github.com/joshjon/go-profiles/internal/validation"
+	"github.com/joshjon/go-profiles/internal/wal"
 	"github.com/soheilhy/cmux"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"io"
 	"net"
 	"sync"
+	"time"
 )
 
 type Config struct {
@@ -18,12 +23,38 @@ type Config struct {
 	NodeName        string
 	ACLModelFile    string
 	ACLPolicyFile   string
+	// DataDir is where profiles are persisted. Profiles are kept in memory only when empty.
+	DataDir              string
+	StoreSyncPolicy      wal.SyncPolicy
+	StoreSyncInterval    time.Duration
+	StoreSegmentBytes    int64
+	StoreCompactInterval time.Duration
+	// TombstoneRetention is how long soft deleted profiles are kept before being purged. They're
+	// kept until explicitly purged when zero.
+	TombstoneRetention time.Duration
+	// WatchHistory is how many profile events are retained for watchers to resume from.
+	WatchHistory int
+	// IdempotencyWindow is how long CreateProfile idempotency keys are remembered for.
+	IdempotencyWindow time.Duration
+	// ValidationRulesFile is a YAML file of validation rules profiles must satisfy, if any.
+	ValidationRulesFile string
+	// DuplicateMode is how profiles with the same normalized name as existing profiles are treated
+	// when they're created.
+	DuplicateMode server.DuplicateMode
+	// JWT configures the verification of bearer tokens, which clients may then authenticate with
+	// instead of client certificates, as the token's subject prefixed with jwt:.
+	JWT *auth.JWTConfig
 }
 
 type Agent struct {
 	Config       Config
+	ln           net.Listener
 	mux          cmux.CMux
+	store        server.ProfileStore
+	reaper       *server.Reaper
+	authorizer   *auth.Authorizer
 	server       *grpc.Server
+	done         chan struct{}
 	shutdown     bool
 	shutdownLock sync.Mutex
 }
@@ -33,15 +64,19 @@ func (c Config) RPCAddr() string {
 }
 
 func New(config Config) (*Agent, error) {
-	agent := &Agent{Config: config}
+	agent := &Agent{Config: config, done: make(chan struct{})}
 
 	setup := []func() error{
 		agent.setupMux,
+		agent.setupStore,
+		agent.setupReaper,
 		agent.setupServer,
 	}
 
 	for _, fn := range setup {
 		if err := fn(); err != nil {
+			// Release whatever the steps before it set up.
+			agent.Shutdown()
 			return nil, err
 		}
 	}
@@ -56,6 +91,7 @@ func (a *Agent) setupMux() error {
 	if err != nil {
 		return err
 	}
+	a.ln = ln
 	a.mux = cmux.New(ln)
 	return nil
 }
@@ -68,20 +104,82 @@ func (a *Agent) serve() error {
 	return nil
 }
 
+func (a *Agent) setupStore() error {
+	if a.Config.DataDir == "" {
+		a.store = store.NewMemory()
+		return nil
+	}
+	var err error
+	a.store, err = store.NewDisk(a.Config.DataDir, store.DiskConfig{
+		WAL: wal.Config{
+			Sync:            a.Config.StoreSyncPolicy,
+			SyncInterval:    a.Config.StoreSyncInterval,
+			MaxSegmentBytes: a.Config.StoreSegmentBytes,
+		},
+		CompactInterval: a.Config.StoreCompactInterval,
+	})
+	return err
+}
+
+func (a *Agent) setupReaper() error {
+	if a.Config.TombstoneRetention > 0 {
+		a.reaper = server.NewReaper(a.Config.TombstoneRetention)
+	}
+	return nil
+}
+
 func (a *Agent) setupServer() error {
-	authorizer := auth.New(a.Config.ACLModelFile, a.Config.ACLPolicyFile)
-	serverConfig := &server.Config{Authorizer: authorizer}
+	var err error
+	a.authorizer, err = auth.New(a.Config.ACLModelFile, a.Config.ACLPolicyFile)
+	if err != nil {
+		return err
+	}
+	if err := a.authorizer.Watch(); err != nil {
+		return fmt.Errorf("watch ACL policy: %w", err)
+	}
+	tlsConfig := a.Config.ServerTLSConfig
+	var authenticators []server.Authenticator
+	if a.Config.JWT != nil {
+		verifier, err := auth.NewJWTVerifier(*a.Config.JWT)
+		if err != nil {
+			return fmt.Errorf("set up JWT authentication: %w", err)
+		}
+		authenticators = append(authenticators, server.BearerAuthenticator{Verifier: verifier})
+		// Clients that authenticate with tokens have no certificates to present.
+		if tlsConfig != nil && tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+			tlsConfig = tlsConfig.Clone()
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	authenticators = append(authenticators, server.CertAuthenticator{})
+	serverConfig := &server.Config{
+		Authorizer:        a.authorizer,
+		PolicyAdmin:       a.authorizer,
+		Authenticators:    authenticators,
+		Store:             a.store,
+		WatchHistory:      a.Config.WatchHistory,
+		IdempotencyWindow: a.Config.IdempotencyWindow,
+		DuplicateMode:     a.Config.DuplicateMode,
+		Reaper:            a.reaper,
+		Done:              a.done,
+	}
+	if a.Config.ValidationRulesFile != "" {
+		rules, err := validation.Load(a.Config.ValidationRulesFile)
+		if err != nil {
+			return fmt.Errorf("load validation rules: %w", err)
+		}
+		serverConfig.Rules = rules
+	}
 	var opts []grpc.ServerOption
 
-	if a.Config.ServerTLSConfig != nil {
-		creds := credentials.NewTLS(a.Config.ServerTLSConfig)
+	if tlsConfig != nil {
+		creds := credentials.NewTLS(tlsConfig)
 		opts = append(opts, grpc.Creds(creds))
 	}
 
 	a.server = server.NewGRPCServer(serverConfig, opts...)
 	grpcLn := a.mux.Match(cmux.Any())
 
-	var err error
 	go func() {
 		if err = a.server.Serve(grpcLn); err != nil {
 			a.Shutdown()
@@ -90,6 +188,12 @@ func (a *Agent) setupServer() error {
 	return err
 }
 
+// ReloadACLPolicy loads the ACL policy file again, keeping the current policy if the file is
+// invalid. The policy is also reloaded whenever the file changes.
+func (a *Agent) ReloadACLPolicy() error {
+	return a.authorizer.Reload()
+}
+
 func (a *Agent) Shutdown() {
 	a.shutdownLock.Lock()
 	defer a.shutdownLock.Unlock()
@@ -97,5 +201,20 @@ func (a *Agent) Shutdown() {
 		return
 	}
 	a.shutdown = true
-	a.server.GracefulStop()
+	close(a.done)
+	if a.server != nil {
+		a.server.GracefulStop()
+	} else if a.ln != nil {
+		// Stopping the server closes the listener, unless setup failed before there was a server.
+		a.ln.Close()
+	}
+	if a.authorizer != nil {
+		a.authorizer.Close()
+	}
+	if a.reaper != nil {
+		a.reaper.Close()
+	}
+	if closer, ok := a.store.(io.Closer); ok {
+		closer.Close()
+	}
 }
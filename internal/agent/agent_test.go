@@ -0,0 +1,48 @@
+package agent
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joshjon/go-profiles/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReleasesResourcesOnError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "agent-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ln, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	_, err = New(Config{
+		RPCPort:              port,
+		ACLModelFile:         config.ACLModelFile,
+		ACLPolicyFile:        filepath.Join(dir, "missing-policy.csv"),
+		DataDir:              dir,
+		StoreCompactInterval: time.Hour,
+		TombstoneRetention:   time.Hour,
+	})
+	require.Error(t, err)
+
+	// Nothing is left open in the data directory, nor listening on the port.
+	fds, err := ioutil.ReadDir("/proc/self/fd")
+	if err == nil {
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join("/proc/self/fd", fd.Name()))
+			if err == nil {
+				require.False(t, strings.HasPrefix(target, dir), "%s is still open", target)
+			}
+		}
+	}
+	ln, err = net.Listen("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	require.NoError(t, ln.Close())
+}
@@ -1,31 +1,315 @@
 package auth
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/casbin/casbin"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	fileadapter "github.com/casbin/casbin/persist/file-adapter"
+	"github.com/fsnotify/fsnotify"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
-func New(modelFile, policyFile string) *Authorizer {
-	enforcer := casbin.NewEnforcer(modelFile, policyFile)
-	return &Authorizer{
-		enforcer: enforcer,
+// reloadDelay is how long the policy file must go unchanged before a change to it is reloaded, so
+// that a file being written isn't loaded half way through.
+const reloadDelay = 100 * time.Millisecond
+
+// New returns an Authorizer enforcing the policy in policyFile according to the model in
+// modelFile, or an error if either is invalid.
+func New(modelFile, policyFile string) (*Authorizer, error) {
+	a := &Authorizer{modelFile: modelFile, policyFile: filepath.Clean(policyFile)}
+	if err := a.Reload(); err != nil {
+		return nil, err
 	}
+	return a, nil
 }
 
 type Authorizer struct {
-	enforcer *casbin.Enforcer
+	modelFile  string
+	policyFile string
+	// mu serialises reloads and changes to the policy.
+	mu sync.Mutex
+	// enforcer holds the *casbin.Enforcer of the policy last loaded, which is swapped in whole on
+	// reloads so that no decision is made against a partly loaded policy.
+	enforcer atomic.Value
+	watcher  *fsnotify.Watcher
 }
 
 // Authorize returns whether the given subject is permitted to run the given action
-// on the given object based on the model and policy.
-func (a *Authorizer) Authorize(subject, object, action string) error {
-	if !a.enforcer.Enforce(subject, object, action) {
-		msg := fmt.Sprintf("%s not permitted to %s to %s", subject, action, object)
-		st := status.New(codes.PermissionDenied, msg)
-		return st.Err()
+// on the given object, owned by the given owner, based on the model and policy.
+// The subject is also permitted anything one of the given roles is, such as the
+// ou: prefixed organizational units of its certificate, on top of the roles the
+// policy assigns it.
+func (a *Authorizer) Authorize(subject string, roles []string, object, owner, action string) error {
+	enforcer := a.current()
+	if enforcer.Enforce(subject, object, owner, action) {
+		return nil
+	}
+	// The model compares owners to the requesting subject, which for a role is the role, so
+	// roles are asked about the profiles the subject owns as if the role owned them.
+	owned := owner != "" && owner == subject
+	for _, role := range roles {
+		roleOwner := ""
+		if owned {
+			roleOwner = role
+		}
+		if enforcer.Enforce(role, object, roleOwner, action) {
+			return nil
+		}
+	}
+	return api.ErrPermissionDenied{Subject: subject, Object: object, Action: action}
+}
+
+func (a *Authorizer) current() *casbin.Enforcer {
+	return a.enforcer.Load().(*casbin.Enforcer)
+}
+
+// Reload loads the model and policy files again. The previously loaded policy stays in force if
+// either is invalid.
+func (a *Authorizer) Reload() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	enforcer, err := load(a.modelFile, a.policyFile)
+	if err != nil {
+		return err
+	}
+	a.enforcer.Store(enforcer)
+	return nil
+}
+
+// Policies returns every policy rule, in the order they're defined.
+func (a *Authorizer) Policies() []*api.Policy {
+	var policies []*api.Policy
+	for _, rule := range a.current().GetPolicy() {
+		policies = append(policies, &api.Policy{Subject: rule[0], Object: rule[1], Action: rule[2]})
+	}
+	return policies
+}
+
+// RoleAssignments returns every role assignment, in the order they're defined.
+func (a *Authorizer) RoleAssignments() []*api.RoleAssignment {
+	var assignments []*api.RoleAssignment
+	for _, rule := range a.current().GetGroupingPolicy() {
+		assignments = append(assignments, &api.RoleAssignment{Subject: rule[0], Role: rule[1]})
+	}
+	return assignments
+}
+
+// AddPolicy adds a policy rule and saves it to the policy file, returning false if the rule
+// already existed.
+func (a *Authorizer) AddPolicy(policy *api.Policy) (bool, error) {
+	if err := checkRule(policy); err != nil {
+		return false, err
+	}
+	return a.change(func(enforcer *casbin.Enforcer) bool {
+		return enforcer.AddPolicy(policy.GetSubject(), policy.GetObject(), policy.GetAction())
+	})
+}
+
+// RemovePolicy removes a policy rule and saves the policy file, returning false if there was no
+// such rule.
+func (a *Authorizer) RemovePolicy(policy *api.Policy) (bool, error) {
+	return a.change(func(enforcer *casbin.Enforcer) bool {
+		return enforcer.RemovePolicy(policy.GetSubject(), policy.GetObject(), policy.GetAction())
+	})
+}
+
+// AddRoleAssignment gives a subject a role and saves it to the policy file, returning false if the
+// subject already had the role.
+func (a *Authorizer) AddRoleAssignment(assignment *api.RoleAssignment) (bool, error) {
+	if err := checkRule(assignment); err != nil {
+		return false, err
+	}
+	return a.change(func(enforcer *casbin.Enforcer) bool {
+		return enforcer.AddGroupingPolicy(assignment.GetSubject(), assignment.GetRole())
+	})
+}
+
+// RemoveRoleAssignment takes a role away from a subject and saves the policy file, returning false
+// if the subject wasn't assigned the role.
+func (a *Authorizer) RemoveRoleAssignment(assignment *api.RoleAssignment) (bool, error) {
+	return a.change(func(enforcer *casbin.Enforcer) bool {
+		return enforcer.RemoveGroupingPolicy(assignment.GetSubject(), assignment.GetRole())
+	})
+}
+
+// checkRule returns an api.ErrValidation for a policy or role assignment with fields that can't be
+// saved to the policy file, which separates fields with commas and rules with line breaks and has no
+// way of quoting either.
+func checkRule(rule validation.Message) error {
+	violations := validation.CheckFields(rule)
+	if len(violations) == 0 {
+		return nil
+	}
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, len(violations))
+	for i, violation := range violations {
+		fieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		}
+	}
+	return api.ErrValidation{Violations: fieldViolations}
+}
+
+// change applies a change to the policy file and, once it is saved, swaps it in. The change is made
+// to a copy loaded from the file, so that no decision is made against a policy being changed and
+// no change made to the file since it was last loaded is lost.
+func (a *Authorizer) change(apply func(enforcer *casbin.Enforcer) bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	enforcer, err := load(a.modelFile, a.policyFile)
+	if err != nil {
+		return false, err
+	}
+	if !apply(enforcer) {
+		return false, nil
+	}
+	if err := save(enforcer, a.policyFile); err != nil {
+		return false, err
+	}
+	a.enforcer.Store(enforcer)
+	return true, nil
+}
+
+// Watch reloads the policy whenever the policy file changes until the Authorizer is closed,
+// logging the policy files that fail to load.
+func (a *Authorizer) Watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
 	}
+	// The directory is watched rather than the file, as editors often replace files rather than
+	// write to them.
+	if err := watcher.Add(filepath.Dir(a.policyFile)); err != nil {
+		watcher.Close()
+		return err
+	}
+	a.watcher = watcher
+	go a.watch(watcher)
 	return nil
 }
+
+func (a *Authorizer) watch(watcher *fsnotify.Watcher) {
+	var reload <-chan time.Time
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) == a.policyFile && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				reload = time.After(reloadDelay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("watch ACL policy: %v", err)
+		case <-reload:
+			reload = nil
+			if err := a.Reload(); err != nil {
+				log.Printf("reload ACL policy, keeping the previous policy: %v", err)
+			} else {
+				log.Printf("reloaded ACL policy %s", a.policyFile)
+			}
+		}
+	}
+}
+
+// Close stops watching the policy file.
+func (a *Authorizer) Close() error {
+	if a.watcher == nil {
+		return nil
+	}
+	return a.watcher.Close()
+}
+
+// load returns an enforcer for the model and policy files, checking the policy's rules are of the
+// lengths the model defines, which casbin otherwise leaves to panic on the first decision.
+func load(modelFile, policyFile string) (enforcer *casbin.Enforcer, err error) {
+	defer func() {
+		// casbin panics on invalid models and on rules of unknown types.
+		if r := recover(); r != nil {
+			enforcer, err = nil, fmt.Errorf("load ACL model %s and policy %s: %v", modelFile, policyFile, r)
+		}
+	}()
+
+	enforcer = casbin.NewEnforcer(modelFile)
+	enforcer.SetAdapter(fileadapter.NewAdapter(policyFile))
+	// Changes are saved by save instead, as the file adapter doesn't save changes one at a time.
+	enforcer.EnableAutoSave(false)
+	if err := enforcer.LoadPolicy(); err != nil {
+		return nil, fmt.Errorf("load ACL policy %s: %w", policyFile, err)
+	}
+	model := enforcer.GetModel()
+	for ptype, assertion := range model["p"] {
+		for _, rule := range assertion.Policy {
+			if len(rule) != len(assertion.Tokens) {
+				return nil, fmt.Errorf("ACL policy %s: %s rule %s has %d fields, expected %d", policyFile, ptype, strings.Join(rule, ", "), len(rule), len(assertion.Tokens))
+			}
+		}
+	}
+	for ptype, assertion := range model["g"] {
+		fields := strings.Count(assertion.Value, "_")
+		for _, rule := range assertion.Policy {
+			if len(rule) != fields {
+				return nil, fmt.Errorf("ACL policy %s: %s rule %s has %d fields, expected %d", policyFile, ptype, strings.Join(rule, ", "), len(rule), fields)
+			}
+		}
+	}
+	return enforcer, nil
+}
+
+// save writes the enforcer's policy to the policy file, replacing the file once the policy is
+// written in full so that it is never read half written.
+func save(enforcer *casbin.Enforcer, policyFile string) error {
+	var buf bytes.Buffer
+	model := enforcer.GetModel()
+	for _, sec := range []string{"p", "g"} {
+		ptypes := make([]string, 0, len(model[sec]))
+		for ptype := range model[sec] {
+			ptypes = append(ptypes, ptype)
+		}
+		sort.Strings(ptypes)
+		for _, ptype := range ptypes {
+			for _, rule := range model[sec][ptype].Policy {
+				fmt.Fprintf(&buf, "%s, %s\n", ptype, strings.Join(rule, ", "))
+			}
+		}
+	}
+
+	info, err := os.Stat(policyFile)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(policyFile), "."+filepath.Base(policyFile))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(info.Mode()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), policyFile)
+}
@@ -1,9 +1,16 @@
 package auth
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
 	"github.com/joshjon/go-profiles/internal/config"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAuthorizerAccept(t *testing.T) {
@@ -12,25 +19,206 @@ func TestAuthorizerAccept(t *testing.T) {
 		{action: "read"},
 		{action: "update"},
 		{action: "delete"},
+		{action: "purge"},
 	}
 
-	auth := New(config.ACLModelFile, config.ACLPolicyFile)
+	auth, err := New(config.ACLModelFile, config.ACLPolicyFile)
+	require.NoError(t, err)
 	subject := "root"
-	object := "*"
 
 	for _, tc := range testCases {
-		assert.NoError(t, auth.Authorize(subject, object, tc.action), "scenario: root * "+tc.action)
+		assert.NoError(t, auth.Authorize(subject, nil, "*", "", tc.action), "scenario: root * "+tc.action)
+		assert.NoError(t, auth.Authorize(subject, nil, "some-id", "someone", tc.action), "scenario: root some-id "+tc.action)
 	}
 }
 
 func TestAuthorizerDeny(t *testing.T) {
 	var testCases = []struct{ scenario, subject, object, action string }{
 		{scenario: "bad subject", subject: "foo", object: "*", action: "create"},
-		{scenario: "bad object", subject: "root", object: "foo", action: "create"},
 		{scenario: "bad action", subject: "root", object: "*", action: "foo"},
 	}
-	auth := New(config.ACLModelFile, config.ACLPolicyFile)
+	auth, err := New(config.ACLModelFile, config.ACLPolicyFile)
+	require.NoError(t, err)
+	for _, tc := range testCases {
+		assert.Error(t, auth.Authorize(tc.subject, nil, tc.object, "", tc.action), "scenario: "+tc.scenario)
+	}
+}
+
+func TestAuthorizerOwned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorizer-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	policyFile := filepath.Join(dir, "policy.csv")
+	policy := "p, *, owned, read\np, *, owned, update\np, root, *, delete\n"
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte(policy), 0600))
+
+	var testCases = []struct {
+		scenario                       string
+		subject, object, owner, action string
+		allowed                        bool
+	}{
+		{scenario: "owner reads", subject: "alice", object: "1", owner: "alice", action: "read", allowed: true},
+		{scenario: "owner updates", subject: "alice", object: "1", owner: "alice", action: "update", allowed: true},
+		{scenario: "owner deletes", subject: "alice", object: "1", owner: "alice", action: "delete"},
+		{scenario: "other reads", subject: "bob", object: "1", owner: "alice", action: "read"},
+		{scenario: "unowned read", subject: "alice", object: "1", owner: "", action: "read"},
+		{scenario: "collection read", subject: "alice", object: "*", owner: "", action: "read"},
+		{scenario: "root deletes", subject: "root", object: "1", owner: "alice", action: "delete", allowed: true},
+		// A profile with the id owned is only owned by its owner.
+		{scenario: "other reads owned id", subject: "bob", object: "owned", owner: "alice", action: "read"},
+		{scenario: "other updates owned id", subject: "bob", object: "owned", owner: "alice", action: "update"},
+		{scenario: "unowned owned id", subject: "bob", object: "owned", owner: "", action: "read"},
+		{scenario: "owner reads owned id", subject: "alice", object: "owned", owner: "alice", action: "read", allowed: true},
+	}
+	auth, err := New(config.ACLModelFile, policyFile)
+	require.NoError(t, err)
+	for _, tc := range testCases {
+		err := auth.Authorize(tc.subject, nil, tc.object, tc.owner, tc.action)
+		if tc.allowed {
+			assert.NoError(t, err, "scenario: "+tc.scenario)
+		} else {
+			assert.Error(t, err, "scenario: "+tc.scenario)
+		}
+	}
+}
+
+func TestAuthorizerRoles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorizer-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	policyFile := filepath.Join(dir, "policy.csv")
+	policy := `g, admin, editor
+g, editor, viewer
+p, viewer, *, read
+p, editor, *, update
+p, admin, *, delete
+p, viewer, owned, purge
+g, alice, editor
+g, root, admin
+g, ou:Distributed Services, viewer
+g, ou:Admins, admin
+`
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte(policy), 0600))
+
+	var testCases = []struct {
+		scenario              string
+		subject               string
+		roles                 []string
+		object, owner, action string
+		allowed               bool
+	}{
+		{scenario: "assigned role", subject: "alice", object: "1", action: "update", allowed: true},
+		{scenario: "inherited role", subject: "alice", object: "1", action: "read", allowed: true},
+		{scenario: "senior role", subject: "alice", object: "1", action: "delete"},
+		{scenario: "no role", subject: "bob", object: "1", action: "read"},
+		{scenario: "role from unit", subject: "bob", roles: []string{"ou:Admins"}, object: "1", action: "delete", allowed: true},
+		{scenario: "mapped unit", subject: "bob", roles: []string{"ou:Distributed Services"}, object: "1", action: "read", allowed: true},
+		{scenario: "mapped unit senior role", subject: "bob", roles: []string{"ou:Distributed Services"}, object: "1", action: "update"},
+		{scenario: "unit named after subject", subject: "bob", roles: []string{"ou:root"}, object: "1", action: "delete"},
+		{scenario: "unit named after role", subject: "bob", roles: []string{"ou:admin"}, object: "1", action: "delete"},
+		{scenario: "unit owns", subject: "bob", roles: []string{"viewer"}, object: "1", owner: "bob", action: "purge", allowed: true},
+		{scenario: "unit doesn't own", subject: "bob", roles: []string{"viewer"}, object: "1", owner: "alice", action: "purge"},
+		{scenario: "unit named owner", subject: "bob", roles: []string{"viewer"}, object: "1", owner: "viewer", action: "purge"},
+	}
+	auth, err := New(config.ACLModelFile, policyFile)
+	require.NoError(t, err)
 	for _, tc := range testCases {
-		assert.Error(t, auth.Authorize(tc.subject, tc.object, tc.action), "scenario: "+tc.scenario)
+		err := auth.Authorize(tc.subject, tc.roles, tc.object, tc.owner, tc.action)
+		if tc.allowed {
+			assert.NoError(t, err, "scenario: "+tc.scenario)
+		} else {
+			assert.Error(t, err, "scenario: "+tc.scenario)
+		}
 	}
 }
+
+func TestAuthorizerReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorizer-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	policyFile := filepath.Join(dir, "policy.csv")
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte("p, alice, *, read\n"), 0600))
+
+	auth, err := New(config.ACLModelFile, policyFile)
+	require.NoError(t, err)
+	require.NoError(t, auth.Watch())
+	defer auth.Close()
+	require.NoError(t, auth.Authorize("alice", nil, "*", "", "read"))
+	require.Error(t, auth.Authorize("bob", nil, "*", "", "read"))
+
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte("p, bob, *, read\n"), 0600))
+	require.Eventually(t, func() bool {
+		return auth.Authorize("bob", nil, "*", "", "read") == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Error(t, auth.Authorize("alice", nil, "*", "", "read"))
+
+	// Invalid policies are rejected, leaving the previous one in force.
+	for _, policy := range []string{"p, alice, *\n", "g, alice\n", "x, alice, *, read\n"} {
+		require.NoError(t, ioutil.WriteFile(policyFile, []byte(policy), 0600))
+		require.Error(t, auth.Reload(), policy)
+		require.NoError(t, auth.Authorize("bob", nil, "*", "", "read"), policy)
+		require.Error(t, auth.Authorize("alice", nil, "*", "", "read"), policy)
+	}
+	require.NoError(t, os.Remove(policyFile))
+	require.Error(t, auth.Reload())
+	require.NoError(t, auth.Authorize("bob", nil, "*", "", "read"))
+
+	_, err = New(config.ACLModelFile, policyFile)
+	require.Error(t, err)
+}
+
+func TestAuthorizerChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorizer-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	policyFile := filepath.Join(dir, "policy.csv")
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte("p, viewer, *, read\n"), 0640))
+
+	auth, err := New(config.ACLModelFile, policyFile)
+	require.NoError(t, err)
+
+	changed, err := auth.AddRoleAssignment(&api.RoleAssignment{Subject: "alice", Role: "viewer"})
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = auth.AddPolicy(&api.Policy{Subject: "bob", Object: "1", Action: "update"})
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = auth.AddPolicy(&api.Policy{Subject: "bob", Object: "1", Action: "update"})
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.NoError(t, auth.Authorize("alice", nil, "1", "", "read"))
+	require.NoError(t, auth.Authorize("bob", nil, "1", "", "update"))
+
+	changed, err = auth.RemovePolicy(&api.Policy{Subject: "viewer", Object: "*", Action: "read"})
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = auth.RemoveRoleAssignment(&api.RoleAssignment{Subject: "bob", Role: "viewer"})
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Error(t, auth.Authorize("alice", nil, "1", "", "read"))
+
+	require.Equal(t, []*api.Policy{{Subject: "bob", Object: "1", Action: "update"}}, auth.Policies())
+	require.Equal(t, []*api.RoleAssignment{{Subject: "alice", Role: "viewer"}}, auth.RoleAssignments())
+
+	// Fields that would break the policy file up into other rules are rejected.
+	for _, policy := range []*api.Policy{
+		{Subject: "mallory", Object: "*", Action: "read, admin"},
+		{Subject: "mallory", Object: "*", Action: "read\np, mallory, *, admin"},
+		{Subject: `"mallory"`, Object: "*", Action: "read"},
+	} {
+		_, err = auth.AddPolicy(policy)
+		require.IsType(t, api.ErrValidation{}, err)
+	}
+	_, err = auth.AddRoleAssignment(&api.RoleAssignment{Subject: "mallory", Role: "viewer\r\ng, mallory, admin"})
+	require.IsType(t, api.ErrValidation{}, err)
+	require.Error(t, auth.Authorize("mallory", nil, "1", "", "admin"))
+
+	// Changes are saved to the policy file.
+	info, err := os.Stat(policyFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), info.Mode())
+	saved, err := New(config.ACLModelFile, policyFile)
+	require.NoError(t, err)
+	require.Equal(t, auth.Policies(), saved.Policies())
+	require.Equal(t, auth.RoleAssignments(), saved.RoleAssignments())
+}
@@ -0,0 +1,313 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// jwtLeeway is how far clocks may be out between token issuers and the server when checking when
+// tokens expire and become valid.
+const jwtLeeway = time.Minute
+
+// JWTConfig configures how JWTVerifier verifies tokens.
+type JWTConfig struct {
+	// Keys verify tokens' signatures. Tokens naming a key id are only verified by the key with
+	// that id.
+	Keys []JWTKey
+	// SubjectClaim is the claim holding the subject tokens authenticate as. Defaults to sub.
+	SubjectClaim string
+	// Issuer, when set, is the iss claim tokens must have.
+	Issuer string
+	// Audience, when set, is an audience tokens must have in their aud claim.
+	Audience string
+}
+
+// JWTKey is a key that verifies tokens: a []byte secret for HS256, an *rsa.PublicKey for RS256 or
+// an *ecdsa.PublicKey on the P-256 curve for ES256.
+type JWTKey struct {
+	ID  string
+	Key interface{}
+}
+
+// JWTVerifier verifies JSON Web Tokens signed with HS256, RS256 or ES256.
+type JWTVerifier struct {
+	config JWTConfig
+}
+
+// NewJWTVerifier returns a verifier of the tokens signed by the configured keys.
+func NewJWTVerifier(config JWTConfig) (*JWTVerifier, error) {
+	if len(config.Keys) == 0 {
+		return nil, errors.New("no keys to verify JWTs with")
+	}
+	for _, key := range config.Keys {
+		if algorithm(key.Key) == "" {
+			return nil, fmt.Errorf("unsupported JWT key %q of type %T", key.ID, key.Key)
+		}
+	}
+	if config.SubjectClaim == "" {
+		config.SubjectClaim = "sub"
+	}
+	return &JWTVerifier{config: config}, nil
+}
+
+// Verify returns the subject a token authenticates as, once its signature and its exp, nbf, iss
+// and aud claims are verified. Tokens must have an exp claim, so that none is valid forever.
+func (v *JWTVerifier) Verify(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed JWT")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", fmt.Errorf("JWT header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("JWT signature: %w", err)
+	}
+	if !v.verifySignature(header.Alg, header.Kid, []byte(parts[0]+"."+parts[1]), signature) {
+		return "", errors.New("invalid JWT signature")
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return "", fmt.Errorf("JWT claims: %w", err)
+	}
+	if err := v.verifyClaims(claims, time.Now()); err != nil {
+		return "", err
+	}
+	subject, _ := claims[v.config.SubjectClaim].(string)
+	if subject == "" {
+		return "", fmt.Errorf("JWT has no %s claim", v.config.SubjectClaim)
+	}
+	return subject, nil
+}
+
+// verifySignature reports whether one of the keys for the algorithm, and the key id if any,
+// signed the input. The algorithm must be that of the key, so that a token can't have a public key
+// used as an HMAC secret.
+func (v *JWTVerifier) verifySignature(alg, kid string, input, signature []byte) bool {
+	digest := sha256.Sum256(input)
+	for _, key := range v.config.Keys {
+		if kid != "" && key.ID != kid || algorithm(key.Key) != alg {
+			continue
+		}
+		switch key := key.Key.(type) {
+		case []byte:
+			mac := hmac.New(sha256.New, key)
+			mac.Write(input)
+			if hmac.Equal(mac.Sum(nil), signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil {
+				return true
+			}
+		case *ecdsa.PublicKey:
+			// ES256 signatures are the 32 byte big-endian r and s concatenated.
+			if len(signature) != 64 {
+				continue
+			}
+			r := new(big.Int).SetBytes(signature[:32])
+			s := new(big.Int).SetBytes(signature[32:])
+			if ecdsa.Verify(key, digest[:], r, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *JWTVerifier) verifyClaims(claims map[string]interface{}, now time.Time) error {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("JWT has no exp claim")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(jwtLeeway)) {
+		return errors.New("JWT has expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Before(time.Unix(int64(nbf), 0).Add(-jwtLeeway)) {
+		return errors.New("JWT is not valid yet")
+	}
+	if v.config.Issuer != "" && claims["iss"] != v.config.Issuer {
+		return fmt.Errorf("JWT was not issued by %s", v.config.Issuer)
+	}
+	if v.config.Audience != "" {
+		audience := false
+		switch aud := claims["aud"].(type) {
+		case string:
+			audience = aud == v.config.Audience
+		case []interface{}:
+			for _, a := range aud {
+				audience = audience || a == v.config.Audience
+			}
+		}
+		if !audience {
+			return fmt.Errorf("JWT is not intended for %s", v.config.Audience)
+		}
+	}
+	return nil
+}
+
+// Returns the algorithm a key verifies, or "" if it verifies none of those supported.
+func algorithm(key interface{}) string {
+	switch key := key.(type) {
+	case []byte:
+		return "HS256"
+	case *rsa.PublicKey:
+		return "RS256"
+	case *ecdsa.PublicKey:
+		if key.Curve == elliptic.P256() {
+			return "ES256"
+		}
+	}
+	return ""
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// LoadSecret loads an HS256 secret from a file, ignoring leading and trailing whitespace.
+func LoadSecret(file string) (JWTKey, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return JWTKey{}, err
+	}
+	secret := bytes.TrimSpace(b)
+	if len(secret) == 0 {
+		return JWTKey{}, fmt.Errorf("%s is empty", file)
+	}
+	return JWTKey{Key: secret}, nil
+}
+
+// LoadPublicKey loads an RSA or P-256 ECDSA public key from a PEM file.
+func LoadPublicKey(file string) (JWTKey, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return JWTKey{}, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return JWTKey{}, fmt.Errorf("no PEM data in %s", file)
+	}
+	var key interface{}
+	if block.Type == "RSA PUBLIC KEY" {
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return JWTKey{}, fmt.Errorf("parse public key %s: %w", file, err)
+	}
+	return JWTKey{Key: key}, nil
+}
+
+// LoadJWKS loads the keys of a JSON Web Key Set file. Keys that aren't for verifying signatures
+// with a supported algorithm are skipped, but at least one must be.
+func LoadJWKS(file string) ([]JWTKey, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			Alg string `json:"alg"`
+			K   string `json:"k"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("parse JWKS %s: %w", file, err)
+	}
+
+	var keys []JWTKey
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var key interface{}
+		switch {
+		case jwk.Kty == "oct":
+			key, err = base64.RawURLEncoding.DecodeString(jwk.K)
+		case jwk.Kty == "RSA":
+			key, err = rsaKey(jwk.N, jwk.E)
+		case jwk.Kty == "EC" && jwk.Crv == "P-256":
+			key, err = ecdsaKey(jwk.X, jwk.Y)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse JWKS %s key %q: %w", file, jwk.Kid, err)
+		}
+		if jwk.Alg != "" && jwk.Alg != algorithm(key) {
+			continue
+		}
+		keys = append(keys, JWTKey{ID: jwk.Kid, Key: key})
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys in JWKS %s verify HS256, RS256 or ES256 signatures", file)
+	}
+	return keys, nil
+}
+
+func rsaKey(n, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, err
+	}
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(eb)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("exponent too large")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exponent.Int64())}, nil
+}
+
+func ecdsaKey(x, y string) (*ecdsa.PublicKey, error) {
+	xb, err := base64.RawURLEncoding.DecodeString(x)
+	if err != nil {
+		return nil, err
+	}
+	yb, err := base64.RawURLEncoding.DecodeString(y)
+	if err != nil {
+		return nil, err
+	}
+	key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(xb), Y: new(big.Int).SetBytes(yb)}
+	if !key.Curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("point not on curve")
+	}
+	return key, nil
+}
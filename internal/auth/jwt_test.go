@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sign returns a token with the header and claims, signed by the key: a []byte secret, an
+// *rsa.PrivateKey or an *ecdsa.PrivateKey.
+func sign(t *testing.T, header, claims map[string]interface{}, key interface{}) string {
+	segment := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	input := segment(header) + "." + segment(claims)
+	digest := sha256.Sum256([]byte(input))
+	var signature []byte
+	switch key := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(input))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestJWTVerifier(t *testing.T) {
+	secret := []byte("secret")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	verifier, err := NewJWTVerifier(JWTConfig{
+		Keys: []JWTKey{
+			{Key: secret},
+			{ID: "rsa", Key: &rsaKey.PublicKey},
+			{ID: "ec", Key: &ecKey.PublicKey},
+		},
+		Issuer:   "issuer",
+		Audience: "profiles",
+	})
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	claims := func(extra map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{"sub": "alice", "iss": "issuer", "aud": []string{"other", "profiles"}, "exp": now + 60}
+		for k, v := range extra {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+	hs256 := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+	var testCases = []struct {
+		scenario string
+		token    string
+		valid    bool
+	}{
+		{scenario: "HS256", token: sign(t, hs256, claims(nil), secret), valid: true},
+		{scenario: "RS256", token: sign(t, map[string]interface{}{"alg": "RS256", "kid": "rsa"}, claims(nil), rsaKey), valid: true},
+		{scenario: "ES256", token: sign(t, map[string]interface{}{"alg": "ES256", "kid": "ec"}, claims(nil), ecKey), valid: true},
+		{scenario: "ES256 without key id", token: sign(t, map[string]interface{}{"alg": "ES256"}, claims(nil), ecKey), valid: true},
+		{scenario: "audience string", token: sign(t, hs256, claims(map[string]interface{}{"aud": "profiles"}), secret), valid: true},
+		{scenario: "unknown signer", token: sign(t, map[string]interface{}{"alg": "ES256"}, claims(nil), otherKey)},
+		{scenario: "wrong key id", token: sign(t, map[string]interface{}{"alg": "ES256", "kid": "rsa"}, claims(nil), ecKey)},
+		{scenario: "wrong secret", token: sign(t, hs256, claims(nil), []byte("guess"))},
+		{scenario: "public key as secret", token: sign(t, hs256, claims(nil), x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey))},
+		{scenario: "none", token: sign(t, map[string]interface{}{"alg": "none"}, claims(nil), []byte(nil))},
+		{scenario: "expired", token: sign(t, hs256, claims(map[string]interface{}{"exp": now - 120}), secret)},
+		{scenario: "no expiry", token: sign(t, hs256, claims(map[string]interface{}{"exp": nil}), secret)},
+		{scenario: "not yet valid", token: sign(t, hs256, claims(map[string]interface{}{"nbf": now + 120}), secret)},
+		{scenario: "wrong issuer", token: sign(t, hs256, claims(map[string]interface{}{"iss": "other"}), secret)},
+		{scenario: "wrong audience", token: sign(t, hs256, claims(map[string]interface{}{"aud": "other"}), secret)},
+		{scenario: "no subject", token: sign(t, hs256, claims(map[string]interface{}{"sub": ""}), secret)},
+		{scenario: "malformed", token: "not.a.token"},
+	}
+	for _, tc := range testCases {
+		subject, err := verifier.Verify(tc.token)
+		if tc.valid {
+			assert.NoError(t, err, "scenario: "+tc.scenario)
+			assert.Equal(t, "alice", subject, "scenario: "+tc.scenario)
+		} else {
+			assert.Error(t, err, "scenario: "+tc.scenario)
+		}
+	}
+
+	verifier, err = NewJWTVerifier(JWTConfig{Keys: []JWTKey{{Key: secret}}, SubjectClaim: "email"})
+	require.NoError(t, err)
+	subject, err := verifier.Verify(sign(t, hs256, map[string]interface{}{"sub": "1", "email": "alice@example.com", "exp": now + 60}, secret))
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", subject)
+
+	_, err = NewJWTVerifier(JWTConfig{})
+	require.Error(t, err)
+	_, err = NewJWTVerifier(JWTConfig{Keys: []JWTKey{{Key: rsaKey}}})
+	require.Error(t, err)
+}
+
+func TestLoadJWTKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jwt-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+	jwks, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{
+		{"kty": "oct", "kid": "hmac", "k": encode([]byte("secret"))},
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "alg": "RS256", "n": encode(rsaKey.N.Bytes()), "e": encode(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X.Bytes()), "y": encode(ecKey.Y.Bytes())},
+		{"kty": "RSA", "kid": "encryption", "use": "enc", "n": encode(rsaKey.N.Bytes()), "e": "AQAB"},
+		{"kty": "OKP", "kid": "ed25519", "crv": "Ed25519", "x": "AA"},
+	}})
+	require.NoError(t, err)
+	jwksFile := filepath.Join(dir, "jwks.json")
+	require.NoError(t, ioutil.WriteFile(jwksFile, jwks, 0600))
+	keys, err := LoadJWKS(jwksFile)
+	require.NoError(t, err)
+	require.Equal(t, []JWTKey{
+		{ID: "hmac", Key: []byte("secret")},
+		{ID: "rsa", Key: &rsaKey.PublicKey},
+		{ID: "ec", Key: &ecKey.PublicKey},
+	}, keys)
+
+	require.NoError(t, ioutil.WriteFile(jwksFile, []byte(`{"keys": []}`), 0600))
+	_, err = LoadJWKS(jwksFile)
+	require.Error(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	pemFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, ioutil.WriteFile(pemFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+	key, err := LoadPublicKey(pemFile)
+	require.NoError(t, err)
+	require.Equal(t, &ecKey.PublicKey, key.Key)
+
+	secretFile := filepath.Join(dir, "secret")
+	require.NoError(t, ioutil.WriteFile(secretFile, []byte("secret\n"), 0600))
+	key, err = LoadSecret(secretFile)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), key.Key)
+}
@@ -0,0 +1,290 @@
+// Package search indexes the words of documents such as profiles' names so they can be searched by
+// prefix and with misspellings.
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// How much a query word matching a document word counts towards the document's score, by the kind
+// of match. Prefix and fuzzy matches are scaled down further by how much of the word differs.
+const (
+	exactScore  = 1.0
+	prefixScore = 0.8
+	fuzzyScore  = 0.6
+)
+
+// Index is an inverted index from normalized words to the documents containing them. It is safe
+// for concurrent use.
+type Index struct {
+	// weights holds how much a match counts for in each field. Fields without a weight count 1.
+	weights map[string]float64
+
+	mu sync.RWMutex
+	// postings holds the documents each word appears in, with the weight of the heaviest field it
+	// appears in.
+	postings map[string]map[string]float64
+	// words holds the words of each document, to remove them when the document changes.
+	words map[string][]string
+	// sorted holds every word in postings in order, for prefix matching. It is rebuilt on the next
+	// search after it becomes stale.
+	sorted []string
+	stale  bool
+}
+
+// Hit is a document matching a search.
+type Hit struct {
+	ID    string
+	Score float64
+}
+
+// Options are the options for a search.
+type Options struct {
+	// Limit is the maximum number of hits returned. No limit is applied when zero.
+	Limit int
+	// Fuzzy enables matching words within a number of edits of each other that depends on the
+	// length of the query word.
+	Fuzzy bool
+}
+
+// NewIndex returns an empty index weighting matches in each field by weights.
+func NewIndex(weights map[string]float64) *Index {
+	return &Index{
+		weights:  weights,
+		postings: make(map[string]map[string]float64),
+		words:    make(map[string][]string),
+	}
+}
+
+// Put indexes the words of a document's fields, keyed by field name, replacing any previously
+// indexed for the document.
+func (i *Index) Put(id string, fields map[string]string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(id)
+	weights := make(map[string]float64)
+	for field, text := range fields {
+		weight, ok := i.weights[field]
+		if !ok {
+			weight = 1
+		}
+		for _, word := range Tokenize(text) {
+			if weight > weights[word] {
+				weights[word] = weight
+			}
+		}
+	}
+	words := make([]string, 0, len(weights))
+	for word, weight := range weights {
+		docs, ok := i.postings[word]
+		if !ok {
+			docs = make(map[string]float64)
+			i.postings[word] = docs
+			i.stale = true
+		}
+		docs[id] = weight
+		words = append(words, word)
+	}
+	if len(words) > 0 {
+		i.words[id] = words
+	}
+}
+
+// Remove removes a document from the index.
+func (i *Index) Remove(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.remove(id)
+}
+
+func (i *Index) remove(id string) {
+	for _, word := range i.words[id] {
+		docs := i.postings[word]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(i.postings, word)
+			i.stale = true
+		}
+	}
+	delete(i.words, id)
+}
+
+// Search returns the documents matching every word of the query, most relevant first. A query word
+// matches a document word that is the same, that it is a prefix of, or, for fuzzy searches, that is
+// within MaxEdits of it. Documents score the sum over the query words of their best match.
+func (i *Index) Search(query string, opts Options) []Hit {
+	queryWords := Tokenize(query)
+	if len(queryWords) == 0 {
+		return nil
+	}
+
+	i.mu.Lock()
+	if i.stale {
+		i.sorted = i.sorted[:0]
+		for word := range i.postings {
+			i.sorted = append(i.sorted, word)
+		}
+		sort.Strings(i.sorted)
+		i.stale = false
+	}
+	i.mu.Unlock()
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var scores map[string]float64
+	for _, queryWord := range queryWords {
+		matches := i.match(queryWord, opts.Fuzzy)
+		if scores == nil {
+			scores = matches
+			continue
+		}
+		for id := range scores {
+			if score, ok := matches[id]; ok {
+				scores[id] += score
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Score != hits[b].Score {
+			return hits[a].Score > hits[b].Score
+		}
+		return hits[a].ID < hits[b].ID
+	})
+	if opts.Limit > 0 && len(hits) > opts.Limit {
+		hits = hits[:opts.Limit]
+	}
+	return hits
+}
+
+// match returns the best score of each document with a word matching the query word. Callers must
+// hold mu.
+func (i *Index) match(queryWord string, fuzzy bool) map[string]float64 {
+	scores := make(map[string]float64)
+	add := func(word string, score float64) {
+		for id, weight := range i.postings[word] {
+			if score*weight > scores[id] {
+				scores[id] = score * weight
+			}
+		}
+	}
+
+	queryLen := utf8.RuneCountInString(queryWord)
+	for n := sort.SearchStrings(i.sorted, queryWord); n < len(i.sorted) && strings.HasPrefix(i.sorted[n], queryWord); n++ {
+		word := i.sorted[n]
+		if word == queryWord {
+			add(word, exactScore)
+		} else {
+			add(word, prefixScore*float64(queryLen)/float64(utf8.RuneCountInString(word)))
+		}
+	}
+
+	if maxEdits := MaxEdits(queryWord); fuzzy && maxEdits > 0 {
+		query := []rune(queryWord)
+		for _, word := range i.sorted {
+			if strings.HasPrefix(word, queryWord) {
+				continue
+			}
+			if edits := distance(query, []rune(word), maxEdits); edits <= maxEdits {
+				add(word, fuzzyScore*(1-float64(edits)/float64(queryLen)))
+			}
+		}
+	}
+	return scores
+}
+
+// MaxEdits returns how many edits a word may be from a query word to match it in a fuzzy search:
+// none for words under 3 characters, 1 for words of 3 to 5 characters and 2 for longer words.
+func MaxEdits(queryWord string) int {
+	switch n := utf8.RuneCountInString(queryWord); {
+	case n < 3:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// distance returns the number of insertions, deletions, substitutions and transpositions of
+// adjacent characters needed to turn a into b, or max+1 if it is more than max.
+func distance(a, b []rune, max int) int {
+	if d := len(a) - len(b); d > max || -d > max {
+		return max + 1
+	}
+	// Rows of the optimal string alignment distance matrix: two back, previous and current.
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = minInt(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	if prev[len(b)] > max {
+		return max + 1
+	}
+	return prev[len(b)]
+}
+
+func minInt(n int, ns ...int) int {
+	for _, m := range ns {
+		if m < n {
+			n = m
+		}
+	}
+	return n
+}
+
+// Tokenize splits text into normalized words: lower case, without diacritics, and split on
+// anything other than letters and digits. Apostrophes are dropped rather than split on, so O'Brien
+// is the single word obrien.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(Normalize(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Normalize returns text in lower case without diacritics or apostrophes.
+func Normalize(text string) string {
+	// Transformers keep state, so one is needed per call.
+	fold := transform.Chain(
+		norm.NFD,
+		runes.Remove(runes.In(unicode.Mn)),
+		runes.Remove(runes.Predicate(func(r rune) bool { return r == '\'' || r == '’' })),
+		norm.NFC,
+	)
+	folded, _, err := transform.String(fold, text)
+	if err != nil {
+		folded = text
+	}
+	return strings.ToLower(folded)
+}
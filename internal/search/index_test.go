@@ -0,0 +1,81 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ids(hits []Hit) []string {
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	index := NewIndex(map[string]float64{"nickname": 0.5})
+	index.Put("1", map[string]string{"first_name": "José", "last_name": "Núñez"})
+	index.Put("2", map[string]string{"first_name": "Joseph", "last_name": "O'Brien"})
+	index.Put("3", map[string]string{"first_name": "Johnathan", "last_name": "Smith", "nickname": "Jo"})
+
+	testCases := []struct {
+		query string
+		fuzzy bool
+		ids   []string
+	}{
+		{query: "jose", ids: []string{"1", "2"}},
+		{query: "JOSÉ NUÑEZ", ids: []string{"1"}},
+		{query: "nunez", ids: []string{"1"}},
+		{query: "obrien", ids: []string{"2"}},
+		{query: "o'brien jos", ids: []string{"2"}},
+		{query: "jo", ids: []string{"3", "1", "2"}},
+		{query: "smtih", ids: nil},
+		{query: "smtih", fuzzy: true, ids: []string{"3"}},
+		{query: "jonathan smith", fuzzy: true, ids: []string{"3"}},
+		{query: "jospeh", fuzzy: true, ids: []string{"2", "1"}},
+		{query: "!!", ids: nil},
+	}
+	for _, tc := range testCases {
+		hits := index.Search(tc.query, Options{Fuzzy: tc.fuzzy})
+		if tc.ids == nil {
+			assert.Empty(t, hits, tc.query)
+			continue
+		}
+		assert.Equal(t, tc.ids, ids(hits), tc.query)
+	}
+
+	// Exact matches rank above prefixes, which rank above misspellings.
+	index.Put("4", map[string]string{"first_name": "Josie"})
+	hits := index.Search("josie", Options{Fuzzy: true})
+	require.Equal(t, []string{"4", "1"}, ids(hits))
+	assert.Greater(t, hits[0].Score, hits[1].Score)
+	assert.Len(t, index.Search("josie", Options{Fuzzy: true, Limit: 1}), 1)
+
+	index.Put("1", map[string]string{"first_name": "Maria"})
+	assert.Empty(t, index.Search("nunez", Options{}))
+	assert.Equal(t, []string{"1"}, ids(index.Search("maria", Options{})))
+	index.Remove("1")
+	assert.Empty(t, index.Search("maria", Options{}))
+}
+
+func TestDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{a: "john", b: "john", max: 2, want: 0},
+		{a: "jonh", b: "john", max: 2, want: 1},
+		{a: "jon", b: "john", max: 2, want: 1},
+		{a: "smyth", b: "smith", max: 2, want: 1},
+		{a: "kitten", b: "sitting", max: 3, want: 3},
+		{a: "kitten", b: "sitting", max: 2, want: 3},
+		{a: "a", b: "abcd", max: 2, want: 3},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, distance([]rune(tc.a), []rune(tc.b), tc.max), tc.a+" "+tc.b)
+	}
+}
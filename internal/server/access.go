@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+)
+
+// Guarantees *grpcServer satisfies api.AccessControlServiceServer interface.
+var _ api.AccessControlServiceServer = (*grpcServer)(nil)
+
+// PolicyAdmin administers the ACL policy on behalf of AccessControlService. The methods that change
+// the policy persist their changes, returning false when there was nothing to change.
+type PolicyAdmin interface {
+	Policies() []*api.Policy
+	AddPolicy(policy *api.Policy) (bool, error)
+	RemovePolicy(policy *api.Policy) (bool, error)
+	RoleAssignments() []*api.RoleAssignment
+	AddRoleAssignment(assignment *api.RoleAssignment) (bool, error)
+	RemoveRoleAssignment(assignment *api.RoleAssignment) (bool, error)
+}
+
+func (s *grpcServer) ListPolicies(ctx context.Context, req *api.ListPoliciesReq) (*api.ListPoliciesRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", adminAction); err != nil {
+		return nil, err
+	}
+	res := &api.ListPoliciesRes{}
+	for _, policy := range s.PolicyAdmin.Policies() {
+		if req.GetSubject() == "" || policy.GetSubject() == req.GetSubject() {
+			res.Policies = append(res.Policies, policy)
+		}
+	}
+	return res, nil
+}
+
+func (s *grpcServer) AddPolicy(ctx context.Context, req *api.Policy) (*api.ChangePolicyRes, error) {
+	return s.changePolicy(ctx, func() (bool, error) { return s.PolicyAdmin.AddPolicy(req) })
+}
+
+func (s *grpcServer) RemovePolicy(ctx context.Context, req *api.Policy) (*api.ChangePolicyRes, error) {
+	return s.changePolicy(ctx, func() (bool, error) { return s.PolicyAdmin.RemovePolicy(req) })
+}
+
+func (s *grpcServer) ListRoleAssignments(ctx context.Context, req *api.ListRoleAssignmentsReq) (*api.ListRoleAssignmentsRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", adminAction); err != nil {
+		return nil, err
+	}
+	res := &api.ListRoleAssignmentsRes{}
+	for _, assignment := range s.PolicyAdmin.RoleAssignments() {
+		if (req.GetSubject() == "" || assignment.GetSubject() == req.GetSubject()) &&
+			(req.GetRole() == "" || assignment.GetRole() == req.GetRole()) {
+			res.Assignments = append(res.Assignments, assignment)
+		}
+	}
+	return res, nil
+}
+
+func (s *grpcServer) AddRoleAssignment(ctx context.Context, req *api.RoleAssignment) (*api.ChangePolicyRes, error) {
+	return s.changePolicy(ctx, func() (bool, error) { return s.PolicyAdmin.AddRoleAssignment(req) })
+}
+
+func (s *grpcServer) RemoveRoleAssignment(ctx context.Context, req *api.RoleAssignment) (*api.ChangePolicyRes, error) {
+	return s.changePolicy(ctx, func() (bool, error) { return s.PolicyAdmin.RemoveRoleAssignment(req) })
+}
+
+func (s *grpcServer) changePolicy(ctx context.Context, change func() (bool, error)) (*api.ChangePolicyRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", adminAction); err != nil {
+		return nil, err
+	}
+	changed, err := change()
+	if err != nil {
+		return nil, err
+	}
+	return &api.ChangePolicyRes{Changed: changed}, nil
+}
+
+func (s *grpcServer) CheckAccess(ctx context.Context, req *api.CheckAccessReq) (*api.CheckAccessRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", adminAction); err != nil {
+		return nil, err
+	}
+	err := s.Authorizer.Authorize(req.GetSubject(), req.GetRoles(), req.GetObject(), req.GetOwner(), req.GetAction())
+	if _, ok := err.(api.ErrPermissionDenied); err != nil && !ok {
+		return nil, err
+	}
+	return &api.CheckAccessRes{Allowed: err == nil}, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/auth"
+	"github.com/joshjon/go-profiles/internal/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessControl(t *testing.T) {
+	dir, err := ioutil.TempDir("", "access-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	policy, err := ioutil.ReadFile(config.ACLPolicyFile)
+	require.NoError(t, err)
+	policyFile := filepath.Join(dir, "policy.csv")
+	require.NoError(t, ioutil.WriteFile(policyFile, policy, 0600))
+
+	authorizer, err := auth.New(config.ACLModelFile, policyFile)
+	require.NoError(t, err)
+	srv := newgrpcServer(&Config{Authorizer: authorizer, PolicyAdmin: authorizer})
+	root := context.WithValue(context.Background(), subjectContextKey{}, "root")
+	nobody := context.WithValue(context.Background(), subjectContextKey{}, "nobody")
+
+	check := &api.CheckAccessReq{Subject: "nobody", Object: "1", Action: "read"}
+	checked, err := srv.CheckAccess(root, check)
+	require.NoError(t, err)
+	require.False(t, checked.Allowed)
+
+	changed, err := srv.AddRoleAssignment(root, &api.RoleAssignment{Subject: "nobody", Role: "viewer"})
+	require.NoError(t, err)
+	require.True(t, changed.Changed)
+	checked, err = srv.CheckAccess(root, check)
+	require.NoError(t, err)
+	require.True(t, checked.Allowed)
+	_, err = srv.ReadProfile(nobody, &api.ReadProfileReq{Id: "1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	assignments, err := srv.ListRoleAssignments(root, &api.ListRoleAssignmentsReq{Subject: "nobody"})
+	require.NoError(t, err)
+	require.Equal(t, []*api.RoleAssignment{{Subject: "nobody", Role: "viewer"}}, assignments.Assignments)
+	policies, err := srv.ListPolicies(root, &api.ListPoliciesReq{Subject: "viewer"})
+	require.NoError(t, err)
+	require.Equal(t, []*api.Policy{{Subject: "viewer", Object: "*", Action: "read"}}, policies.Policies)
+
+	changed, err = srv.RemoveRoleAssignment(root, &api.RoleAssignment{Subject: "nobody", Role: "viewer"})
+	require.NoError(t, err)
+	require.True(t, changed.Changed)
+	_, err = srv.ReadProfile(nobody, &api.ReadProfileReq{Id: "1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Administering the policy requires the admin action.
+	_, err = srv.AddRoleAssignment(nobody, &api.RoleAssignment{Subject: "nobody", Role: "admin"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.CheckAccess(nobody, check)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.Equal(t, codes.InvalidArgument, status.Code(srv.validateRequest(&api.Policy{Subject: "nobody"})))
+	// Fields can't smuggle other rules into the policy file.
+	err = srv.validateRequest(&api.Policy{Subject: "nobody", Object: "*", Action: "read\np, nobody, *, admin"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = srv.validateRequest(&api.RoleAssignment{Subject: "nobody", Role: "viewer, admin"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.AddPolicy(root, &api.Policy{Subject: "nobody", Object: "*", Action: "read\np, nobody, *, admin"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NotContains(t, srv.PolicyAdmin.Policies(), &api.Policy{Subject: "nobody", Object: "*", Action: "admin"})
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Identity is who the client of an RPC authenticated as.
+type Identity struct {
+	// Subject is who policies are checked for.
+	Subject string
+	// Roles are roles the client has on top of those the policy assigns the subject.
+	Roles []string
+}
+
+// Authenticator identifies the client of an RPC from its credentials. Authenticate returns a nil
+// Identity, and no error, when the RPC carries none of the credentials it handles, so that the
+// next authenticator is tried. Invalid credentials are an error.
+type Authenticator interface {
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+// unitRolePrefix prefixes the roles of certificates' organizational units, so that no unit is taken
+// for the common name of another client or for a role the policy defines.
+const unitRolePrefix = "ou:"
+
+// CertAuthenticator authenticates clients by their verified TLS certificates, as the subject of
+// their common name with a role for each of their organizational units, prefixed with ou:. Policies
+// give units roles with lines such as "g, ou:Distributed Services, viewer".
+type CertAuthenticator struct{}
+
+func (CertAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	peer, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	tlsInfo, ok := peer.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return nil, nil
+	}
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	roles := make([]string, len(cert.Subject.OrganizationalUnit))
+	for i, unit := range cert.Subject.OrganizationalUnit {
+		roles[i] = unitRolePrefix + unit
+	}
+	return &Identity{Subject: cert.Subject.CommonName, Roles: roles}, nil
+}
+
+// TokenVerifier verifies bearer tokens, returning the subject they authenticate as.
+type TokenVerifier interface {
+	Verify(token string) (string, error)
+}
+
+// tokenSubjectPrefix prefixes the subjects of bearer tokens, so that no token authenticates as the
+// common name of a certificate or as a role.
+const tokenSubjectPrefix = "jwt:"
+
+// BearerAuthenticator authenticates clients by the token in their authorization header, with the
+// Bearer scheme. Their subject is the token's prefixed with jwt:, so policies name the client of a
+// token for alice as jwt:alice.
+type BearerAuthenticator struct {
+	Verifier TokenVerifier
+}
+
+func (a BearerAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		scheme, token := value, ""
+		if i := strings.IndexByte(value, ' '); i >= 0 {
+			scheme, token = value[:i], strings.TrimSpace(value[i+1:])
+		}
+		if !strings.EqualFold(scheme, "bearer") {
+			continue
+		}
+		subject, err := a.Verifier.Verify(token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+		}
+		return &Identity{Subject: tokenSubjectPrefix + subject}, nil
+	}
+	return nil, nil
+}
+
+// Interceptor that identifies the client with the first authenticator that finds credentials it
+// handles, and writes its subject and roles to the RPC’s context.
+func (s *grpcServer) authenticate(ctx context.Context) (context.Context, error) {
+	for _, authenticator := range s.Authenticators {
+		identity, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			return ctx, err
+		}
+		if identity == nil {
+			continue
+		}
+		ctx = context.WithValue(ctx, subjectContextKey{}, identity.Subject)
+		ctx = context.WithValue(ctx, rolesContextKey{}, identity.Roles)
+		return ctx, nil
+	}
+	return ctx, status.New(codes.Unauthenticated, "no credentials, such as a client certificate, were given").Err()
+}
+
+func subject(ctx context.Context) string {
+	return ctx.Value(subjectContextKey{}).(string)
+}
+
+func roles(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesContextKey{}).([]string)
+	return roles
+}
+
+type subjectContextKey struct{}
+
+type rolesContextKey struct{}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// tokenSubjects verifies tokens that are the subject they authenticate as prefixed with "token-".
+type tokenSubjects struct{}
+
+func (tokenSubjects) Verify(token string) (string, error) {
+	if len(token) > len("token-") && token[:len("token-")] == "token-" {
+		return token[len("token-"):], nil
+	}
+	return "", errors.New("unknown token")
+}
+
+// staticAuthenticator authenticates every RPC as the same identity.
+type staticAuthenticator Identity
+
+func (a staticAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	identity := Identity(a)
+	return &identity, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}, Authenticators: []Authenticator{
+		BearerAuthenticator{Verifier: tokenSubjects{}},
+		CertAuthenticator{},
+	}})
+	withAuthorization := func(values ...string) context.Context {
+		md := metadata.MD{}
+		for _, value := range values {
+			md.Append("authorization", value)
+		}
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	ctx, err := srv.authenticate(withAuthorization("Bearer token-alice"))
+	require.NoError(t, err)
+	require.Equal(t, "jwt:alice", subject(ctx))
+	require.Empty(t, roles(ctx))
+
+	ctx, err = srv.authenticate(withAuthorization("Basic YWxpY2U6", "bearer token-bob"))
+	require.NoError(t, err)
+	require.Equal(t, "jwt:bob", subject(ctx))
+
+	// Tokens can't pass for the certificate or role of the same name.
+	ctx, err = srv.authenticate(withAuthorization("Bearer token-root"))
+	require.NoError(t, err)
+	require.Equal(t, "jwt:root", subject(ctx))
+
+	_, err = srv.authenticate(withAuthorization("Bearer forged"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Neither a token nor a certificate.
+	_, err = srv.authenticate(withAuthorization("Basic YWxpY2U6"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.authenticate(context.Background())
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Authenticators are tried in order.
+	srv.Authenticators = append(srv.Authenticators, staticAuthenticator{Subject: "carol", Roles: []string{"viewer"}})
+	ctx, err = srv.authenticate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "carol", subject(ctx))
+	require.Equal(t, []string{"viewer"}, roles(ctx))
+	ctx, err = srv.authenticate(withAuthorization("Bearer token-alice"))
+	require.NoError(t, err)
+	require.Equal(t, "jwt:alice", subject(ctx))
+}
+
+func TestCertAuthenticator(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "bob", OrganizationalUnit: []string{"root", "Distributed Services"}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+
+	// Units can't pass for the client or role of the same name.
+	identity, err := CertAuthenticator{}.Authenticate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Subject: "bob", Roles: []string{"ou:root", "ou:Distributed Services"}}, identity)
+
+	identity, err = CertAuthenticator{}.Authenticate(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, identity)
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchSize = 1000
+
+func (s *grpcServer) BatchCreateProfiles(ctx context.Context, req *api.BatchCreateProfilesReq) (*api.BatchProfilesRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", createAction); err != nil {
+		return nil, err
+	}
+
+	dtos := req.GetProfiles()
+	return s.batch(ctx, req.GetMode(), len(dtos), api.ProfileEvent_CREATED, func(i int) (store.Mutation, error) {
+		if err := s.validateRequest(dtos[i]); err != nil {
+			return store.Mutation{}, err
+		}
+		if id := dtos[i].GetId(); id != "" {
+			if _, err := s.Store.Get(id); err == nil {
+				return store.Mutation{}, api.ErrProfileExists{Id: id}
+			}
+		}
+		if err := s.checkDuplicates(ctx, dtos[i]); err != nil {
+			return store.Mutation{}, err
+		}
+		profile, err := newProfile(ctx, dtos[i])
+		return store.Mutation{Op: store.OpCreate, Profile: profile}, err
+	})
+}
+
+// Updates and deletes are authorized item by item, as part of preparing them.
+func (s *grpcServer) BatchUpdateProfiles(ctx context.Context, req *api.BatchUpdateProfilesReq) (*api.BatchProfilesRes, error) {
+	reqs := req.GetRequests()
+	return s.batch(ctx, req.GetMode(), len(reqs), api.ProfileEvent_UPDATED, func(i int) (store.Mutation, error) {
+		// Partial updates are validated once their update mask has been applied.
+		if !reqs[i].IsPartial() {
+			if err := s.validateRequest(reqs[i]); err != nil {
+				return store.Mutation{}, err
+			}
+		}
+		profile, err := s.updatedProfile(ctx, reqs[i])
+		return store.Mutation{Op: store.OpUpdate, Profile: profile}, err
+	})
+}
+
+func (s *grpcServer) BatchDeleteProfiles(ctx context.Context, req *api.BatchDeleteProfilesReq) (*api.BatchProfilesRes, error) {
+	reqs := req.GetRequests()
+	return s.batch(ctx, req.GetMode(), len(reqs), api.ProfileEvent_DELETED, func(i int) (store.Mutation, error) {
+		profile, err := s.deletedProfile(ctx, reqs[i])
+		return store.Mutation{Op: store.OpUpdate, Profile: profile}, err
+	})
+}
+
+// batch prepares the mutation for each of n items and applies them according to the mode,
+// returning a result for each item.
+func (s *grpcServer) batch(ctx context.Context, mode api.BatchMode, n int, eventType api.ProfileEvent_Type, prepare func(i int) (store.Mutation, error)) (*api.BatchProfilesRes, error) {
+	if n > maxBatchSize {
+		return nil, api.ErrQuotaExceeded{Quota: "batch_size", Limit: maxBatchSize}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := &api.BatchProfilesRes{Results: make([]*api.BatchProfileResult, n)}
+	if mode == api.BatchMode_BEST_EFFORT {
+		for i := 0; i < n; i++ {
+			mutation, err := prepare(i)
+			res.Results[i] = s.applyBatchItem(ctx, eventType, mutation, err)
+		}
+		return res, nil
+	}
+
+	// All or nothing: every item is prepared against the same state, so none may depend on another.
+	mutations := make([]store.Mutation, 0, n)
+	errs := make([]error, n)
+	failed := false
+	seen := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		mutation, err := prepare(i)
+		if err == nil && seen[mutation.Profile.GetId()] {
+			err = status.Errorf(codes.InvalidArgument, "%s appears more than once in the batch", mutation.Profile.GetId())
+		}
+		if err != nil {
+			errs[i] = err
+			failed = true
+			continue
+		}
+		seen[mutation.Profile.GetId()] = true
+		mutations = append(mutations, mutation)
+	}
+	if failed {
+		for i, err := range errs {
+			if err == nil {
+				err = status.Error(codes.Aborted, "not applied because another item in the batch failed")
+			}
+			res.Results[i] = &api.BatchProfileResult{Status: rpcStatus(ctx, err)}
+		}
+		return res, nil
+	}
+	if n == 0 {
+		return res, nil
+	}
+
+	revision, err := s.Store.Apply(mutations)
+	if err != nil {
+		return nil, err
+	}
+	for i, mutation := range mutations {
+		s.publish(eventType, mutation.Profile, revision)
+		res.Results[i] = &api.BatchProfileResult{Status: rpcStatus(ctx, nil), Profile: mutation.Profile}
+	}
+	return res, nil
+}
+
+// applyBatchItem applies a single prepared item of a best effort batch.
+func (s *grpcServer) applyBatchItem(ctx context.Context, eventType api.ProfileEvent_Type, mutation store.Mutation, err error) *api.BatchProfileResult {
+	if err != nil {
+		return &api.BatchProfileResult{Status: rpcStatus(ctx, err)}
+	}
+	revision, err := s.Store.Apply([]store.Mutation{mutation})
+	if err != nil {
+		return &api.BatchProfileResult{Status: rpcStatus(ctx, err)}
+	}
+	s.publish(eventType, mutation.Profile, revision)
+	return &api.BatchProfileResult{Status: rpcStatus(ctx, nil), Profile: mutation.Profile}
+}
+
+// rpcStatus converts err, which may be nil, into the gogo google.rpc.Status it would be sent as,
+// localized for the client.
+func rpcStatus(ctx context.Context, err error) *rpc.Status {
+	st := status.Convert(api.Localize(err, acceptLanguage(ctx))).Proto()
+	details := make([]*types.Any, len(st.GetDetails()))
+	for i, detail := range st.GetDetails() {
+		details[i] = &types.Any{TypeUrl: detail.GetTypeUrl(), Value: detail.GetValue()}
+	}
+	return &rpc.Status{Code: st.GetCode(), Message: st.GetMessage(), Details: details}
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/search"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// duplicatesTrailer is the trailer CreateProfile lists the ids of the profiles a new profile
+// duplicates in, when duplicates are warned about.
+const duplicatesTrailer = "duplicate-profile-ids"
+
+// DuplicateMode is how profiles with the same normalized name as existing profiles are treated
+// when they're created.
+type DuplicateMode int
+
+const (
+	// DuplicatesAllow creates duplicate profiles as any other.
+	DuplicatesAllow DuplicateMode = iota
+	// DuplicatesWarn creates duplicate profiles, listing the profiles they duplicate in a trailer.
+	DuplicatesWarn
+	// DuplicatesReject fails to create duplicate profiles with api.ErrDuplicateProfile.
+	DuplicatesReject
+)
+
+// ParseDuplicateMode parses allow, warn or reject into a DuplicateMode.
+func ParseDuplicateMode(mode string) (DuplicateMode, error) {
+	switch mode {
+	case "allow", "":
+		return DuplicatesAllow, nil
+	case "warn":
+		return DuplicatesWarn, nil
+	case "reject":
+		return DuplicatesReject, nil
+	}
+	return 0, fmt.Errorf("unknown duplicate mode %s, expected allow, warn or reject", mode)
+}
+
+// Returns the name profiles are compared by to detect duplicates: their first and last names in
+// lower case, without diacritics or punctuation.
+func duplicateName(firstName, lastName string) string {
+	return strings.Join(search.Tokenize(firstName+" "+lastName), " ")
+}
+
+// duplicateIndex indexes the profiles that aren't deleted by their duplicate name. It is kept up to
+// date by publish.
+type duplicateIndex struct {
+	mu    sync.RWMutex
+	ids   map[string]map[string]bool
+	names map[string]string
+}
+
+func newDuplicateIndex(profiles []*api.Profile) *duplicateIndex {
+	index := &duplicateIndex{ids: make(map[string]map[string]bool), names: make(map[string]string)}
+	for _, profile := range profiles {
+		index.put(profile)
+	}
+	return index
+}
+
+// put brings the index up to date with a profile that was created, updated or deleted.
+func (d *duplicateIndex) put(profile *api.Profile) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.remove(profile.GetId())
+	if profile.IsDeleted() {
+		return
+	}
+	name := duplicateName(profile.GetFirstName(), profile.GetLastName())
+	if d.ids[name] == nil {
+		d.ids[name] = make(map[string]bool)
+	}
+	d.ids[name][profile.GetId()] = true
+	d.names[profile.GetId()] = name
+}
+
+func (d *duplicateIndex) delete(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.remove(id)
+}
+
+func (d *duplicateIndex) remove(id string) {
+	name, ok := d.names[id]
+	if !ok {
+		return
+	}
+	delete(d.ids[name], id)
+	if len(d.ids[name]) == 0 {
+		delete(d.ids, name)
+	}
+	delete(d.names, id)
+}
+
+// lookup returns the ids of the profiles with the name, in order.
+func (d *duplicateIndex) lookup(name string) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	ids := make([]string, 0, len(d.ids[name]))
+	for id := range d.ids[name] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// clusters returns the names shared by more than one profile after the given name, in order, and at
+// most limit of them.
+func (d *duplicateIndex) clusters(after string, limit int) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var names []string
+	for name, ids := range d.ids {
+		if len(ids) > 1 && name > after {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > limit {
+		names = names[:limit]
+	}
+	return names
+}
+
+// Checks a profile about to be created for duplicates according to the duplicate mode. Callers
+// must hold mu, so that no duplicate is created between the check and the profile being created.
+func (s *grpcServer) checkDuplicates(ctx context.Context, dto *api.ProfileDto) error {
+	if s.DuplicateMode == DuplicatesAllow {
+		return nil
+	}
+	name := duplicateName(dto.GetFirstName(), dto.GetLastName())
+	ids := s.duplicates.lookup(name)
+	if len(ids) == 0 {
+		return nil
+	}
+	if s.DuplicateMode == DuplicatesReject {
+		return api.ErrDuplicateProfile{Name: name, DuplicateIds: ids}
+	}
+	// Fails only when there's no RPC to set the trailer of, in which case there's no one to warn.
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(duplicatesTrailer, strings.Join(ids, ",")))
+	return nil
+}
+
+func (s *grpcServer) FindDuplicates(ctx context.Context, req *api.FindDuplicatesReq) (*api.FindDuplicatesRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", readAction); err != nil {
+		return nil, err
+	}
+
+	after, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// One more than a page is looked up to tell whether there's another page.
+	names := s.duplicates.clusters(string(after), pageSize+1)
+	res := &api.FindDuplicatesRes{}
+	if len(names) > pageSize {
+		names = names[:pageSize]
+		res.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(names[pageSize-1]))
+	}
+	for _, name := range names {
+		cluster := &api.DuplicateCluster{Name: name}
+		for _, id := range s.duplicates.lookup(name) {
+			profile, err := s.Store.Get(id)
+			if _, ok := err.(api.ErrProfileNotFound); ok {
+				// Purged since it was looked up.
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if profile.IsDeleted() {
+				continue
+			}
+			cluster.Profiles = append(cluster.Profiles, profile)
+		}
+		sort.SliceStable(cluster.Profiles, func(i, j int) bool {
+			return cluster.Profiles[i].GetCreateDate().Before(*cluster.Profiles[j].GetCreateDate())
+		})
+		res.Clusters = append(res.Clusters, cluster)
+	}
+	return res, nil
+}
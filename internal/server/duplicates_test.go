@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// trailerStream records the trailer set by a handler.
+type trailerStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestDuplicateModes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), subjectContextKey{}, "root")
+
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}, DuplicateMode: DuplicatesReject})
+	created, err := srv.CreateProfile(ctx, &api.ProfileDto{FirstName: "José", LastName: "Núñez"})
+	require.NoError(t, err)
+	_, err = srv.CreateProfile(ctx, &api.ProfileDto{FirstName: "jose", LastName: "NUNEZ"})
+	require.Equal(t, api.ErrDuplicateProfile{Name: "jose nunez", DuplicateIds: []string{created.Id}}, err)
+	_, err = srv.CreateProfile(ctx, &api.ProfileDto{FirstName: "Josef", LastName: "Núñez"})
+	require.NoError(t, err)
+
+	// Deleted profiles aren't duplicated.
+	_, err = srv.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id})
+	require.NoError(t, err)
+	_, err = srv.CreateProfile(ctx, &api.ProfileDto{FirstName: "Jose", LastName: "Nunez"})
+	require.NoError(t, err)
+
+	srv = newgrpcServer(&Config{Authorizer: allowAll{}, DuplicateMode: DuplicatesWarn})
+	created, err = srv.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	require.NoError(t, err)
+	stream := &trailerStream{}
+	_, err = srv.CreateProfile(grpc.NewContextWithServerTransportStream(ctx, stream), &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	require.NoError(t, err)
+	require.Equal(t, []string{created.Id}, stream.trailer.Get(duplicatesTrailer))
+
+	_, err = ParseDuplicateMode("maybe")
+	require.Error(t, err)
+}
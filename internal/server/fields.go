@@ -0,0 +1,89 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// customAttributesPath prefixes update mask paths naming a single custom attribute, e.g.
+// custom_attributes.department, which is set to the attribute of the same name in the request or
+// removed if the request has none.
+const customAttributesPath = "custom_attributes."
+
+// Sets the profile's fields to those of the DTO.
+func setFields(profile *api.Profile, dto *api.ProfileDto) {
+	profile.FirstName = dto.GetFirstName()
+	profile.LastName = dto.GetLastName()
+	profile.DisplayName = dto.GetDisplayName()
+	profile.Emails = dto.GetEmails()
+	profile.PhoneNumbers = dto.GetPhoneNumbers()
+	profile.PostalAddresses = dto.GetPostalAddresses()
+	profile.DateOfBirth = dto.GetDateOfBirth()
+	profile.Locale = dto.GetLocale()
+	profile.CustomAttributes = dto.GetCustomAttributes()
+}
+
+// Returns a DTO with the profile's fields.
+func dtoOf(profile *api.Profile) *api.ProfileDto {
+	attributes := make(map[string]*types.Value, len(profile.GetCustomAttributes()))
+	for name, value := range profile.GetCustomAttributes() {
+		attributes[name] = value
+	}
+	return &api.ProfileDto{
+		FirstName:        profile.GetFirstName(),
+		LastName:         profile.GetLastName(),
+		DisplayName:      profile.GetDisplayName(),
+		Emails:           profile.GetEmails(),
+		PhoneNumbers:     profile.GetPhoneNumbers(),
+		PostalAddresses:  profile.GetPostalAddresses(),
+		DateOfBirth:      profile.GetDateOfBirth(),
+		Locale:           profile.GetLocale(),
+		CustomAttributes: attributes,
+	}
+}
+
+// Copies the fields named in the request's update mask over the profile's current values. The
+// result is left for the caller to validate.
+func applyUpdateMask(profile *api.Profile, req *api.UpdateProfileReq) (*api.ProfileDto, error) {
+	dto, update := dtoOf(profile), req.GetProfile()
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "first_name":
+			dto.FirstName = update.GetFirstName()
+		case "last_name":
+			dto.LastName = update.GetLastName()
+		case "display_name":
+			dto.DisplayName = update.GetDisplayName()
+		case "emails":
+			dto.Emails = update.GetEmails()
+		case "phone_numbers":
+			dto.PhoneNumbers = update.GetPhoneNumbers()
+		case "postal_addresses":
+			dto.PostalAddresses = update.GetPostalAddresses()
+		case "date_of_birth":
+			dto.DateOfBirth = update.GetDateOfBirth()
+		case "locale":
+			dto.Locale = update.GetLocale()
+		case "custom_attributes":
+			dto.CustomAttributes = update.GetCustomAttributes()
+		default:
+			name := strings.TrimPrefix(path, customAttributesPath)
+			if name == path || name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path: %s", path)
+			}
+			if value, ok := update.GetCustomAttributes()[name]; ok {
+				if dto.CustomAttributes == nil {
+					dto.CustomAttributes = make(map[string]*types.Value)
+				}
+				dto.CustomAttributes[name] = value
+			} else {
+				delete(dto.CustomAttributes, name)
+			}
+		}
+	}
+	return dto, nil
+}
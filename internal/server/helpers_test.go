@@ -46,8 +46,12 @@ func NewTestGRPCServer(certFile string, keyFile string, CAFile string, ACLModelF
 	if err != nil {
 		return nil, err
 	}
+	authorizer, err := auth.New(ACLModelFile, ACLPolicyFile)
+	if err != nil {
+		return nil, err
+	}
 	cfg := &Config{
-		Authorizer: auth.New(ACLModelFile, ACLPolicyFile),
+		Authorizer: authorizer,
 	}
 	serverCreds := credentials.NewTLS(serverTLSConfig)
 	return NewGRPCServer(cfg, grpc.Creds(serverCreds)), nil
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// versionAt returns the version of a profile that was current at the given time, given its versions
+// oldest first.
+func versionAt(versions []*api.Profile, t time.Time) (*api.Profile, bool) {
+	for i := len(versions) - 1; i >= 0; i-- {
+		if !versions[i].GetUpdateDate().After(t) {
+			return versions[i], true
+		}
+	}
+	return nil, false
+}
+
+// A versionPageToken records the revision of the last version returned so the next page starts
+// just before it.
+type versionPageToken struct {
+	ID   string `json:"i"`
+	Last uint64 `json:"r"`
+}
+
+func (t versionPageToken) encode() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeVersionPageToken(token string, req *api.ListProfileVersionsReq) (*versionPageToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+	invalid := status.Error(codes.InvalidArgument, "invalid page_token")
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	t := &versionPageToken{}
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, invalid
+	}
+	if t.ID != req.GetId() {
+		return nil, status.Error(codes.InvalidArgument, "page_token was issued for a different id")
+	}
+	return t, nil
+}
+
+// versionPage returns the page of versions, newest first, after the token along with the token for
+// the page after that. Versions must be given oldest first.
+func versionPage(versions []*api.Profile, req *api.ListProfileVersionsReq) (*api.ListProfileVersionsRes, error) {
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	token, err := decodeVersionPageToken(req.GetPageToken(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListProfileVersionsRes{}
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+		if token != nil && version.GetRevision() >= token.Last {
+			continue
+		}
+		if len(res.Versions) == pageSize {
+			res.NextPageToken = versionPageToken{
+				ID:   req.GetId(),
+				Last: res.Versions[pageSize-1].GetRevision(),
+			}.encode()
+			break
+		}
+		res.Versions = append(res.Versions, version)
+	}
+	return res, nil
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	idempotencyKeyHeader     = "idempotency-key"
+	defaultIdempotencyWindow = 24 * time.Hour
+)
+
+// idempotencyCache remembers the profile created for each subject's idempotency keys for a window
+// of time. It is guarded by grpcServer.mu.
+type idempotencyCache struct {
+	window  time.Duration
+	results map[idempotencyKey]*idempotentResult
+	// expiry holds keys in the order they were added, and so the order they expire in.
+	expiry []idempotencyKey
+}
+
+type idempotencyKey struct {
+	subject string
+	key     string
+}
+
+type idempotentResult struct {
+	// fingerprint is a hash of the request, to detect keys being reused for a different one.
+	fingerprint [sha256.Size]byte
+	profile     *api.Profile
+	added       time.Time
+}
+
+func newIdempotencyCache(window time.Duration) *idempotencyCache {
+	if window <= 0 {
+		window = defaultIdempotencyWindow
+	}
+	return &idempotencyCache{
+		window:  window,
+		results: make(map[idempotencyKey]*idempotentResult),
+	}
+}
+
+// get returns the profile created for the key, or nil if the key hasn't been used within the
+// window. It fails if the key was used for a different request.
+func (c *idempotencyCache) get(key idempotencyKey, fingerprint [sha256.Size]byte) (*api.Profile, error) {
+	c.expire(time.Now())
+	result, ok := c.results[key]
+	if !ok {
+		return nil, nil
+	}
+	if result.fingerprint != fingerprint {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key %s was already used for a different request", key.key)
+	}
+	return result.profile, nil
+}
+
+func (c *idempotencyCache) put(key idempotencyKey, fingerprint [sha256.Size]byte, profile *api.Profile) {
+	c.results[key] = &idempotentResult{fingerprint: fingerprint, profile: profile, added: time.Now()}
+	c.expiry = append(c.expiry, key)
+}
+
+func (c *idempotencyCache) expire(now time.Time) {
+	n := 0
+	for _, key := range c.expiry {
+		if now.Sub(c.results[key].added) < c.window {
+			break
+		}
+		delete(c.results, key)
+		n++
+	}
+	c.expiry = c.expiry[n:]
+}
+
+// Returns the idempotency key of a CreateProfile request, from either its metadata or the DTO, along
+// with a fingerprint of the DTO. The key is empty if the request has none.
+func idempotency(ctx context.Context, dto *api.ProfileDto) (idempotencyKey, [sha256.Size]byte, error) {
+	key := dto.GetIdempotencyKey()
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(idempotencyKeyHeader); len(values) > 0 {
+		if key != "" && key != values[0] {
+			return idempotencyKey{}, [sha256.Size]byte{}, status.Error(codes.InvalidArgument, "idempotency key header and field differ")
+		}
+		key = values[0]
+	}
+	if key == "" {
+		return idempotencyKey{}, [sha256.Size]byte{}, nil
+	}
+
+	// The key itself isn't part of the request it identifies. The DTO is fingerprinted as JSON,
+	// which unlike the wire format orders map entries, such as custom attributes, by key.
+	unkeyed := *dto
+	unkeyed.IdempotencyKey = ""
+	b, err := (&jsonpb.Marshaler{}).MarshalToString(&unkeyed)
+	if err != nil {
+		// Such as for a custom attribute with no kind of value, which has no JSON form.
+		return idempotencyKey{}, [sha256.Size]byte{}, status.Errorf(codes.InvalidArgument, "fingerprint idempotent request: %v", err)
+	}
+	return idempotencyKey{subject: subject(ctx), key: key}, sha256.Sum256([]byte(b)), nil
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIdempotencyCacheExpiry(t *testing.T) {
+	cache := newIdempotencyCache(time.Hour)
+	fingerprint := sha256.Sum256([]byte("foo"))
+	first, second := idempotencyKey{subject: "root", key: "1"}, idempotencyKey{subject: "root", key: "2"}
+	cache.put(first, fingerprint, &api.Profile{Id: "1"})
+	cache.put(second, fingerprint, &api.Profile{Id: "2"})
+	cache.results[first].added = time.Now().Add(-2 * time.Hour)
+
+	profile, err := cache.get(first, fingerprint)
+	require.NoError(t, err)
+	require.Nil(t, profile)
+	profile, err = cache.get(second, fingerprint)
+	require.NoError(t, err)
+	require.Equal(t, "2", profile.Id)
+
+	// Keys belong to the subject that used them.
+	profile, err = cache.get(idempotencyKey{subject: "nobody", key: "2"}, fingerprint)
+	require.NoError(t, err)
+	require.Nil(t, profile)
+}
+
+func TestIdempotentRetryWithCustomAttributes(t *testing.T) {
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}})
+	ctx := context.WithValue(context.Background(), subjectContextKey{}, "root")
+	dto := func() *api.ProfileDto {
+		attributes := make(map[string]*types.Value)
+		for i := 0; i < 8; i++ {
+			attributes[fmt.Sprintf("attribute%d", i)] = &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(i)}}
+		}
+		return &api.ProfileDto{FirstName: "Foo", LastName: "Bar", IdempotencyKey: "1", CustomAttributes: attributes}
+	}
+
+	created, err := srv.CreateProfile(ctx, dto())
+	require.NoError(t, err)
+	// Map entries are marshalled in a random order, so retries are repeated to catch them being
+	// fingerprinted in that order.
+	for i := 0; i < 20; i++ {
+		retried, err := srv.CreateProfile(ctx, dto())
+		require.NoError(t, err)
+		require.Equal(t, created.Id, retried.Id)
+	}
+}
+
+func TestCreateWithKindlessCustomAttribute(t *testing.T) {
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}})
+	ctx := context.WithValue(context.Background(), subjectContextKey{}, "root")
+	dto := &api.ProfileDto{FirstName: "Foo", LastName: "Bar", CustomAttributes: map[string]*types.Value{"empty": {}}}
+
+	// Requests without a key aren't fingerprinted.
+	_, err := srv.CreateProfile(ctx, dto)
+	require.NoError(t, err)
+
+	dto.IdempotencyKey = "1"
+	_, err = srv.CreateProfile(ctx, dto)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
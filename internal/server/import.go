@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// importProgressInterval is how many records are imported between progress messages.
+	importProgressInterval = 100
+	// importRetention is how long an idle import can be resumed for.
+	importRetention = 24 * time.Hour
+)
+
+func (s *grpcServer) ImportProfiles(stream api.ProfileService_ImportProfilesServer) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, objectWildcard, "", createAction); err != nil {
+		return err
+	}
+
+	var state *importState
+	var errs []*api.ImportRecordError
+	progress := func() error {
+		err := stream.Send(&api.ImportProfilesRes{
+			ImportId:     state.id,
+			NextSequence: state.next,
+			Accepted:     state.accepted,
+			Rejected:     state.rejected,
+			Errors:       errs,
+		})
+		errs = nil
+		return err
+	}
+
+	for pending := 0; ; {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			if state == nil {
+				return nil
+			}
+			return progress()
+		}
+		if err != nil {
+			return err
+		}
+
+		if state == nil {
+			if req.GetImportId() == "" {
+				return status.Error(codes.InvalidArgument, "import_id is required")
+			}
+			if state, err = s.imports.acquire(subject(ctx), req.GetImportId()); err != nil {
+				return err
+			}
+			defer s.imports.release(state)
+		}
+		if req.GetProfile() == nil {
+			if err := progress(); err != nil {
+				return err
+			}
+			pending = 0
+			continue
+		}
+
+		switch sequence := req.GetSequence(); {
+		case sequence < state.next:
+			// Already imported before the client reconnected.
+			continue
+		case sequence > state.next:
+			return status.Errorf(codes.InvalidArgument, "expected record %d, got %d", state.next, sequence)
+		}
+		if err = s.validateRequest(req.GetProfile()); err == nil {
+			err = s.importProfile(ctx, req.GetProfile())
+		}
+		switch status.Code(err) {
+		case codes.OK:
+			state.accepted++
+		case codes.InvalidArgument, codes.AlreadyExists:
+			state.rejected++
+			errs = append(errs, &api.ImportRecordError{Sequence: state.next, Status: rpcStatus(ctx, err)})
+		default:
+			// Failing to store a record ends the stream without acknowledging it, so that it's
+			// retried when the import is resumed.
+			return err
+		}
+		state.next++
+
+		if pending++; pending == importProgressInterval {
+			if err := progress(); err != nil {
+				return err
+			}
+			pending = 0
+		}
+	}
+}
+
+func (s *grpcServer) importProfile(ctx context.Context, dto *api.ProfileDto) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkDuplicates(ctx, dto); err != nil {
+		return err
+	}
+	profile, err := newProfile(ctx, dto)
+	if err != nil {
+		return err
+	}
+	revision, err := s.Store.Create(profile)
+	if err != nil {
+		return err
+	}
+	s.publish(api.ProfileEvent_CREATED, profile, revision)
+	return nil
+}
+
+// importTracker remembers the progress of each subject's imports so they can be resumed. Progress is
+// kept in memory, so imports can't be resumed across restarts.
+type importTracker struct {
+	mu      sync.Mutex
+	imports map[importKey]*importState
+}
+
+type importKey struct {
+	subject string
+	id      string
+}
+
+// importState is the progress of an import. Only the stream that acquired it may access it.
+type importState struct {
+	id       string
+	next     uint64
+	accepted uint64
+	rejected uint64
+	active   bool
+	released time.Time
+}
+
+func newImportTracker() *importTracker {
+	return &importTracker{imports: make(map[importKey]*importState)}
+}
+
+// acquire returns the state of the import, starting it if it's new, for the exclusive use of one
+// stream until it is released.
+func (t *importTracker) acquire(subject, id string) (*importState, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for key, state := range t.imports {
+		if !state.active && now.Sub(state.released) > importRetention {
+			delete(t.imports, key)
+		}
+	}
+
+	key := importKey{subject: subject, id: id}
+	state, ok := t.imports[key]
+	if !ok {
+		state = &importState{id: id}
+		t.imports[key] = state
+	}
+	if state.active {
+		return nil, status.Errorf(codes.FailedPrecondition, "import %s is already in progress on another stream", id)
+	}
+	state.active = true
+	return state, nil
+}
+
+func (t *importTracker) release(state *importState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state.active = false
+	state.released = time.Now()
+}
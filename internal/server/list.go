@@ -0,0 +1,224 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// Fields profiles can be listed in order of, each compared by sortKey.
+var orderFields = map[string]func(profile *api.Profile) string{
+	"create_date": func(profile *api.Profile) string { return timeKey(profile.GetCreateDate()) },
+	"update_date": func(profile *api.Profile) string { return timeKey(profile.GetUpdateDate()) },
+	"last_name":   func(profile *api.Profile) string { return profile.GetLastName() },
+}
+
+type ordering struct {
+	field string
+	desc  bool
+}
+
+func parseOrderBy(orderBy string) (ordering, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return ordering{field: "create_date"}, nil
+	}
+	o := ordering{field: parts[0]}
+	if _, ok := orderFields[o.field]; !ok || len(parts) > 2 || len(parts) == 2 && parts[1] != "desc" && parts[1] != "asc" {
+		return o, status.Errorf(codes.InvalidArgument, "invalid order_by: %s", orderBy)
+	}
+	o.desc = len(parts) == 2 && parts[1] == "desc"
+	return o, nil
+}
+
+// sortKey orders profiles by the ordering field, breaking ties by id so that every profile has a
+// distinct position in the listing.
+func (o ordering) sortKey(profile *api.Profile) cursor {
+	return cursor{Key: orderFields[o.field](profile), ID: profile.GetId()}
+}
+
+// before reports whether a sorts before b.
+func (o ordering) before(a, b cursor) bool {
+	if a.Key == b.Key {
+		return a.ID < b.ID
+	}
+	return (a.Key < b.Key) != o.desc
+}
+
+// A pageToken records the sort key of the last profile returned so the next page starts just after
+// it. Because it doesn't record a position, profiles created or deleted between pages never cause
+// others to be skipped or repeated.
+type pageToken struct {
+	OrderBy string `json:"o"`
+	Filter  string `json:"f"`
+	Last    cursor `json:"l"`
+}
+
+type cursor struct {
+	Key string `json:"k"`
+	ID  string `json:"i"`
+}
+
+func (t pageToken) encode() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token string, req *api.ListProfilesReq) (*pageToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+	invalid := status.Error(codes.InvalidArgument, "invalid page_token")
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	t := &pageToken{}
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, invalid
+	}
+	if t.OrderBy != req.GetOrderBy() || t.Filter != req.GetFilter() {
+		return nil, status.Error(codes.InvalidArgument, "page_token was issued for a different order_by or filter")
+	}
+	return t, nil
+}
+
+type profileFilter struct {
+	expression *govaluate.EvaluableExpression
+}
+
+func parseFilter(filter string) (*profileFilter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return &profileFilter{}, nil
+	}
+	expression, err := govaluate.NewEvaluableExpression(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	return &profileFilter{expression: expression}, nil
+}
+
+func (f *profileFilter) matches(profile *api.Profile) (bool, error) {
+	if f.expression == nil {
+		return true, nil
+	}
+	// Dates are compared as unix seconds, which is what govaluate converts date literals to.
+	result, err := f.expression.Evaluate(map[string]interface{}{
+		"id":           profile.GetId(),
+		"first_name":   profile.GetFirstName(),
+		"last_name":    profile.GetLastName(),
+		"display_name": profile.GetDisplayName(),
+		"locale":       profile.GetLocale(),
+		"owner":        profile.GetOwner(),
+		"create_date":  unixSeconds(profile.GetCreateDate()),
+		"update_date":  unixSeconds(profile.GetUpdateDate()),
+	})
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	matched, ok := result.(bool)
+	if !ok {
+		return false, status.Error(codes.InvalidArgument, "invalid filter: must evaluate to true or false")
+	}
+	return matched, nil
+}
+
+// selectProfiles returns the profiles matching the filter, sorted by the ordering.
+func selectProfiles(profiles []*api.Profile, order ordering, filter *profileFilter, showDeleted bool) ([]*api.Profile, error) {
+	var matched []*api.Profile
+	for _, profile := range profiles {
+		if profile.IsDeleted() && !showDeleted {
+			continue
+		}
+		ok, err := filter.matches(profile)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, profile)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return order.before(order.sortKey(matched[i]), order.sortKey(matched[j]))
+	})
+	return matched, nil
+}
+
+// listPage filters and orders profiles, returning the page after the token along with the token for
+// the page after that and the total number of profiles matching the filter.
+func listPage(profiles []*api.Profile, req *api.ListProfilesReq) (*api.ListProfilesRes, error) {
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	order, err := parseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	token, err := decodePageToken(req.GetPageToken(), req)
+	if err != nil {
+		return nil, err
+	}
+	matched, err := selectProfiles(profiles, order, filter, req.GetShowDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if token != nil {
+		start = sort.Search(len(matched), func(i int) bool {
+			return order.before(token.Last, order.sortKey(matched[i]))
+		})
+	}
+	end := start + pageSize
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	res := &api.ListProfilesRes{
+		Profiles:  matched[start:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		res.NextPageToken = pageToken{
+			OrderBy: req.GetOrderBy(),
+			Filter:  req.GetFilter(),
+			Last:    order.sortKey(matched[end-1]),
+		}.encode()
+	}
+	return res, nil
+}
+
+// timeKey formats t so that times sort lexically in chronological order.
+func timeKey(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02T15:04:05.000000000")
+}
+
+func unixSeconds(t *time.Time) float64 {
+	if t == nil {
+		return 0
+	}
+	return float64(t.UnixNano()) / float64(time.Second)
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Interceptor that localizes the api.Error a handler fails with for the client's accept-language
+// header.
+func localize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err != nil {
+		return nil, api.Localize(err, acceptLanguage(ctx))
+	}
+	return res, nil
+}
+
+// Interceptor that localizes errors as localize does for streams.
+func localizeStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return api.Localize(handler(srv, stream), acceptLanguage(stream.Context()))
+}
+
+// Returns the client's accept-language header, or an empty string if it didn't send one.
+func acceptLanguage(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return strings.Join(md.Get(api.AcceptLanguageHeader), ",")
+}
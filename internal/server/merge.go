@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) MergeProfiles(ctx context.Context, req *api.MergeProfilesReq) (*api.Profile, error) {
+	if req.GetSurvivorId() == req.GetMergedId() {
+		return nil, status.Error(codes.InvalidArgument, "a profile can't be merged into itself")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	survivor, err := s.getLive(ctx, req.GetSurvivorId(), updateAction)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(survivor, req.GetExpectedSurvivorRevision()); err != nil {
+		return nil, err
+	}
+	merged, err := s.getLive(ctx, req.GetMergedId(), deleteAction)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(merged, req.GetExpectedMergedRevision()); err != nil {
+		return nil, err
+	}
+
+	mergeFields(survivor, merged)
+	// The merged profile's emails and so on may take the survivor over their limits.
+	if err := s.validateRequest(dtoOf(survivor)); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	survivor.UpdateDate = &now
+	survivor.UpdatedBy = subject(ctx)
+	merged.DeleteDate = &now
+	merged.UpdateDate = &now
+	merged.UpdatedBy = subject(ctx)
+	merged.MergedInto = survivor.GetId()
+
+	revision, err := s.Store.Apply([]store.Mutation{
+		{Op: store.OpUpdate, Profile: survivor},
+		{Op: store.OpUpdate, Profile: merged},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publish(api.ProfileEvent_UPDATED, survivor, revision)
+	s.publish(api.ProfileEvent_DELETED, merged, revision)
+	return survivor, nil
+}
+
+// Merges the fields of one profile into another: fields left empty in the survivor are filled from
+// the merged profile, and the merged profile's emails, phone numbers, postal addresses and custom
+// attributes that the survivor doesn't have are added to it.
+func mergeFields(survivor, merged *api.Profile) {
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+	fill(&survivor.FirstName, merged.GetFirstName())
+	fill(&survivor.LastName, merged.GetLastName())
+	fill(&survivor.DisplayName, merged.GetDisplayName())
+	fill(&survivor.DateOfBirth, merged.GetDateOfBirth())
+	fill(&survivor.Locale, merged.GetLocale())
+
+	for _, email := range merged.GetEmails() {
+		found := false
+		for _, existing := range survivor.GetEmails() {
+			found = found || strings.EqualFold(existing.GetAddress(), email.GetAddress())
+		}
+		if !found {
+			survivor.Emails = append(survivor.Emails, email)
+		}
+	}
+	for _, phone := range merged.GetPhoneNumbers() {
+		found := false
+		for _, existing := range survivor.GetPhoneNumbers() {
+			found = found || existing.GetNumber() == phone.GetNumber()
+		}
+		if !found {
+			survivor.PhoneNumbers = append(survivor.PhoneNumbers, phone)
+		}
+	}
+	for _, address := range merged.GetPostalAddresses() {
+		found := false
+		for _, existing := range survivor.GetPostalAddresses() {
+			found = found || proto.Equal(existing, address)
+		}
+		if !found {
+			survivor.PostalAddresses = append(survivor.PostalAddresses, address)
+		}
+	}
+	for name, value := range merged.GetCustomAttributes() {
+		if _, ok := survivor.CustomAttributes[name]; ok {
+			continue
+		}
+		if survivor.CustomAttributes == nil {
+			survivor.CustomAttributes = make(map[string]*types.Value)
+		}
+		survivor.CustomAttributes[name] = value
+	}
+}
+
+// Returns the profile with the given id or, if it was merged into another, the profile it was
+// merged into.
+func (s *grpcServer) resolve(id string) (*api.Profile, error) {
+	profile, err := s.Store.Get(id)
+	for err == nil && profile.GetMergedInto() != "" {
+		profile, err = s.Store.Get(profile.GetMergedInto())
+	}
+	if _, ok := err.(api.ErrProfileNotFound); ok {
+		// Including when the profile it was merged into has since been purged.
+		return nil, api.ErrProfileNotFound{Id: id}
+	}
+	return profile, err
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ownedOnly permits any subject to create profiles, and to read and update the profiles it owns.
+type ownedOnly struct{}
+
+func (ownedOnly) Authorize(subject string, roles []string, object, owner, action string) error {
+	if object == objectWildcard && action == createAction || owner != "" && owner == subject && action != deleteAction {
+		return nil
+	}
+	return api.ErrPermissionDenied{Subject: subject, Object: object, Action: action}
+}
+
+func TestOwnership(t *testing.T) {
+	srv := newgrpcServer(&Config{Authorizer: ownedOnly{}})
+	alice := context.WithValue(context.Background(), subjectContextKey{}, "alice")
+	bob := context.WithValue(context.Background(), subjectContextKey{}, "bob")
+
+	created, err := srv.CreateProfile(alice, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	require.NoError(t, err)
+	require.Equal(t, "alice", created.Owner)
+
+	_, err = srv.ReadProfile(alice, &api.ReadProfileReq{Id: created.Id})
+	require.NoError(t, err)
+	_, err = srv.UpdateProfile(alice, &api.UpdateProfileReq{
+		Id:         created.Id,
+		Profile:    &api.ProfileDto{FirstName: "Baz"},
+		UpdateMask: &types.FieldMask{Paths: []string{"first_name"}},
+	})
+	require.NoError(t, err)
+	_, err = srv.DeleteProfile(alice, &api.DeleteProfileReq{Id: created.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.ReadProfile(bob, &api.ReadProfileReq{Id: created.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.UpdateProfile(bob, &api.UpdateProfileReq{
+		Id:         created.Id,
+		Profile:    &api.ProfileDto{FirstName: "Qux"},
+		UpdateMask: &types.FieldMask{Paths: []string{"first_name"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Subjects can't tell profiles they may not read from ones that don't exist.
+	_, err = srv.ReadProfile(bob, &api.ReadProfileReq{Id: "missing"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+)
+
+// Reaper periodically purges profiles that have been soft deleted for longer than the retention.
+// It's started by the server it's configured for, and purges profiles as PurgeProfile does, so
+// purges are published to watchers and removed from the search and duplicate indexes.
+type Reaper struct {
+	Retention time.Duration
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewReaper returns a reaper purging profiles deleted for longer than the retention, once it's
+// set as the Reaper of a server's Config.
+func NewReaper(retention time.Duration) *Reaper {
+	return &Reaper{
+		Retention: retention,
+		done:      make(chan struct{}),
+	}
+}
+
+// start checks for expired tombstones every retention period, or every hour if the retention is
+// longer than that, until the reaper is closed.
+func (r *Reaper) start(s *grpcServer) {
+	interval := r.Retention
+	if interval > time.Hour {
+		interval = time.Hour
+	}
+	r.wg.Add(1)
+	go r.run(s, interval)
+}
+
+func (r *Reaper) run(s *grpcServer, interval time.Duration) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if _, err := s.reap(now.Add(-r.Retention)); err != nil {
+				log.Printf("reap deleted profiles: %v", err)
+			}
+		case <-r.done:
+			return
+		}
+	}
+}
+
+func (r *Reaper) Close() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+// Purges every profile deleted before the cutoff and returns how many were purged. Each profile is
+// read again under mu before it's purged, so that one undeleted or deleted again since the profiles
+// were listed is kept.
+func (s *grpcServer) reap(cutoff time.Time) (int, error) {
+	profiles, err := s.Store.List()
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, listed := range profiles {
+		if !expired(listed, cutoff) {
+			continue
+		}
+		ok, err := s.reapProfile(listed, cutoff)
+		if err != nil {
+			return purged, err
+		}
+		if ok {
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// Purges the profile if it's unchanged since it was listed, reporting whether it was purged.
+func (s *grpcServer) reapProfile(listed *api.Profile, cutoff time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.Store.Get(listed.GetId())
+	if _, ok := err.(api.ErrProfileNotFound); ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if profile.GetRevision() != listed.GetRevision() || !expired(profile, cutoff) {
+		return false, nil
+	}
+
+	revision, err := s.Store.Delete(profile.GetId())
+	if err != nil {
+		return false, err
+	}
+	s.publish(api.ProfileEvent_DELETED, &api.Profile{Id: profile.GetId()}, revision)
+	return true, nil
+}
+
+// Reports whether the profile was soft deleted before the cutoff. Profiles merged into others never
+// expire, since they're kept to redirect reads to the profile they were merged into.
+func expired(profile *api.Profile, cutoff time.Time) bool {
+	return profile.IsDeleted() && profile.GetMergedInto() == "" && !profile.GetDeleteDate().After(cutoff)
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaperPurgesExpiredTombstones(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-2 * time.Hour)
+	recent := now.Add(-time.Minute)
+
+	s := store.NewMemory()
+	for _, profile := range []*api.Profile{
+		{Id: "live"},
+		{Id: "expired", DeleteDate: &expired},
+		{Id: "recent", DeleteDate: &recent},
+		{Id: "merged", DeleteDate: &expired, MergedInto: "live"},
+	} {
+		_, err := s.Create(profile)
+		require.NoError(t, err)
+	}
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}, Store: s})
+	w, _, err := srv.hub.subscribe(0)
+	require.NoError(t, err)
+
+	purged, err := srv.reap(now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+
+	_, err = s.Get("expired")
+	require.Equal(t, api.ErrProfileNotFound{Id: "expired"}, err)
+	count, err := s.Count()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	// Watchers see reaped profiles purged, as if by PurgeProfile.
+	event := <-w.events
+	require.Equal(t, api.ProfileEvent_DELETED, event.GetType())
+	require.Equal(t, "expired", event.GetProfile().GetId())
+	require.Equal(t, s.Revision(), event.GetRevision())
+}
+
+// undeletingStore undeletes a profile, once set to, after listing the profiles.
+type undeletingStore struct {
+	ProfileStore
+	undelete func()
+}
+
+func (s *undeletingStore) List() ([]*api.Profile, error) {
+	profiles, err := s.ProfileStore.List()
+	if s.undelete != nil {
+		s.undelete()
+	}
+	return profiles, err
+}
+
+func TestReaperKeepsProfilesUndeletedWhileReaping(t *testing.T) {
+	expired := time.Now().Add(-2 * time.Hour)
+	s := &undeletingStore{ProfileStore: store.NewMemory()}
+	_, err := s.Create(&api.Profile{Id: "expired", DeleteDate: &expired})
+	require.NoError(t, err)
+
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}, Store: s})
+	ctx := context.WithValue(context.Background(), subjectContextKey{}, "root")
+	s.undelete = func() {
+		_, err := srv.UndeleteProfile(ctx, &api.ReadProfileReq{Id: "expired"})
+		require.NoError(t, err)
+	}
+
+	purged, err := srv.reap(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, purged)
+
+	profile, err := s.Get("expired")
+	require.NoError(t, err)
+	require.False(t, profile.IsDeleted())
+}
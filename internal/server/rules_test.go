@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/validation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type allowAll struct{}
+
+func (allowAll) Authorize(subject string, roles []string, object, owner, action string) error {
+	return nil
+}
+
+func TestValidationRules(t *testing.T) {
+	rules, err := validation.Parse([]byte("fields: {last_name: {max_length: 3}}"))
+	require.NoError(t, err)
+	srv := newgrpcServer(&Config{Authorizer: allowAll{}, Rules: rules})
+	ctx := context.WithValue(context.Background(), subjectContextKey{}, "root")
+
+	require.Equal(t, codes.InvalidArgument, status.Code(srv.validateRequest(&api.ProfileDto{FirstName: "Foo", LastName: "Quux"})))
+	created, err := srv.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	require.NoError(t, err)
+
+	// Requests the interceptors leave to their handlers are checked too.
+	_, err = srv.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:         created.Id,
+		Profile:    &api.ProfileDto{LastName: "Quux"},
+		UpdateMask: &types.FieldMask{Paths: []string{"last_name"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	res, err := srv.BatchCreateProfiles(ctx, &api.BatchCreateProfilesReq{
+		Profiles: []*api.ProfileDto{{FirstName: "Foo", LastName: "Quux"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(codes.InvalidArgument), res.Results[0].Status.Code)
+	_, err = srv.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:         created.Id,
+		Profile:    &api.ProfileDto{CustomAttributes: map[string]*types.Value{"kindless": {}}},
+		UpdateMask: &types.FieldMask{Paths: []string{"custom_attributes.kindless"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/search"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// How much a match in each indexed field of a profile counts towards its relevance.
+var searchWeights = map[string]float64{
+	"first_name":   1,
+	"last_name":    1,
+	"display_name": 0.8,
+}
+
+func (s *grpcServer) SearchProfiles(ctx context.Context, req *api.SearchProfilesReq) (*api.SearchProfilesRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", readAction); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = defaultSearchPageSize
+	} else if limit > maxSearchPageSize {
+		limit = maxSearchPageSize
+	}
+	hits := s.index.Search(req.GetQuery(), search.Options{Limit: limit, Fuzzy: !req.GetDisableFuzzy()})
+
+	res := &api.SearchProfilesRes{Results: make([]*api.SearchResult, 0, len(hits))}
+	for _, hit := range hits {
+		profile, err := s.Store.Get(hit.ID)
+		if _, ok := err.(api.ErrProfileNotFound); ok {
+			// Purged since it was searched.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if profile.IsDeleted() {
+			continue
+		}
+		res.Results = append(res.Results, &api.SearchResult{Profile: profile, Score: hit.Score})
+	}
+	return res, nil
+}
+
+// newSearchIndex returns an index of the profiles that aren't deleted.
+func newSearchIndex(profiles []*api.Profile) *search.Index {
+	index := search.NewIndex(searchWeights)
+	for _, profile := range profiles {
+		indexProfile(index, profile)
+	}
+	return index
+}
+
+// indexProfile brings the index up to date with a profile that was created, updated or deleted.
+func indexProfile(index *search.Index, profile *api.Profile) {
+	if profile.IsDeleted() {
+		index.Remove(profile.GetId())
+		return
+	}
+	index.Put(profile.GetId(), map[string]string{
+		"first_name":   profile.GetFirstName(),
+		"last_name":    profile.GetLastName(),
+		"display_name": profile.GetDisplayName(),
+	})
+}
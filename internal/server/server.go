@@ -4,19 +4,20 @@ import (
 	"context"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
-	grpcValidator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/search"
+	"github.com/joshjon/go-profiles/internal/store"
+	"github.com/joshjon/go-profiles/internal/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"log"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -26,131 +27,707 @@ const (
 	readAction     = "read"
 	updateAction   = "update"
 	deleteAction   = "delete"
+	purgeAction    = "purge"
+	adminAction    = "admin"
 )
 
 // Guarantees *grpcServer satisfies api.LogServer interface.
 var _ api.ProfileServiceServer = (*grpcServer)(nil)
 
+// Guarantees *store.Memory satisfies ProfileStore interface.
+var _ ProfileStore = (*store.Memory)(nil)
+
 type Config struct {
 	Authorizer Authorizer
+	// Store persists profiles. Defaults to an in-memory store when nil.
+	Store ProfileStore
+	// WatchHistory is how many of the most recent profile events are retained for watchers to
+	// resume from. Defaults to 1000.
+	WatchHistory int
+	// IdempotencyWindow is how long CreateProfile remembers idempotency keys for. Defaults to 24
+	// hours.
+	IdempotencyWindow time.Duration
+	// Rules are checked by profiles on top of their generated validators. Only the generated
+	// validators are checked when nil.
+	Rules *validation.Rules
+	// DuplicateMode is how profiles with the same normalized name as existing profiles are treated
+	// when they're created. Defaults to DuplicatesAllow.
+	DuplicateMode DuplicateMode
+	// PolicyAdmin administers the ACL policy for AccessControlService, which is only served when it
+	// is set.
+	PolicyAdmin PolicyAdmin
+	// Authenticators identify the clients of RPCs, each tried in turn until one finds credentials
+	// it handles. Defaults to authenticating clients by their certificates alone.
+	Authenticators []Authenticator
+	// Reaper, when set, is started by the server to purge profiles soft deleted for longer than its
+	// retention. Its owner must close it before closing the store.
+	Reaper *Reaper
+	// Done is closed when the server is stopping, ending the streams of watchers, which would
+	// otherwise keep a graceful stop waiting until their clients went away. Close it before
+	// GracefulStop.
+	Done <-chan struct{}
 }
 
 type grpcServer struct {
 	*Config
-	mu       *sync.RWMutex
-	profiles []*api.Profile
+	// mu serialises mutations so that read-modify-write handlers don't interleave and events are
+	// published in revision order.
+	mu          sync.Mutex
+	hub         *watchHub
+	imports     *importTracker
+	idempotency *idempotencyCache
+	// index and duplicates are kept up to date by publish.
+	index      *search.Index
+	duplicates *duplicateIndex
 }
 
 func newgrpcServer(config *Config) *grpcServer {
-	return &grpcServer{
-		Config: config,
-		mu:     &sync.RWMutex{},
+	if config.Store == nil {
+		config.Store = store.NewMemory()
+	}
+	if len(config.Authenticators) == 0 {
+		config.Authenticators = []Authenticator{CertAuthenticator{}}
+	}
+	profiles, err := config.Store.List()
+	if err != nil {
+		log.Printf("index profiles for search: %v", err)
 	}
+	srv := &grpcServer{
+		Config:      config,
+		hub:         newWatchHub(config.Store.Revision(), config.WatchHistory),
+		imports:     newImportTracker(),
+		idempotency: newIdempotencyCache(config.IdempotencyWindow),
+		index:       newSearchIndex(profiles),
+		duplicates:  newDuplicateIndex(profiles),
+	}
+	if config.Reaper != nil {
+		config.Reaper.start(srv)
+	}
+	return srv
 }
 
 func NewGRPCServer(config *Config, grpcOpts ...grpc.ServerOption) *grpc.Server {
-	grpcOpts = append(grpcOpts, grpc.UnaryInterceptor(
-		grpcMiddleware.ChainUnaryServer(
-			grpcAuth.UnaryServerInterceptor(authenticate),
-			grpcValidator.UnaryServerInterceptor(),
+	srv := newgrpcServer(config)
+	grpcOpts = append(grpcOpts,
+		grpc.UnaryInterceptor(
+			grpcMiddleware.ChainUnaryServer(
+				localize,
+				grpcAuth.UnaryServerInterceptor(srv.authenticate),
+				srv.validate,
+			),
+		),
+		grpc.StreamInterceptor(
+			grpcMiddleware.ChainStreamServer(
+				localizeStream,
+				grpcAuth.StreamServerInterceptor(srv.authenticate),
+				srv.validateStream,
+			),
 		),
-	))
+	)
 	gsrv := grpc.NewServer(grpcOpts...)
 	hsrv := health.NewServer()
 	hsrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
 	healthpb.RegisterHealthServer(gsrv, hsrv)
-	srv := newgrpcServer(config)
 	api.RegisterProfileServiceServer(gsrv, srv)
+	if config.PolicyAdmin != nil {
+		api.RegisterAccessControlServiceServer(gsrv, srv)
+	}
 	return gsrv
 }
 
 func (s *grpcServer) CreateProfile(ctx context.Context, req *api.ProfileDto) (*api.Profile, error) {
-	if err := s.Authorizer.Authorize(subject(ctx), objectWildcard, createAction); err != nil {
+	if err := s.authorize(ctx, objectWildcard, "", createAction); err != nil {
+		return nil, err
+	}
+
+	key, fingerprint, err := idempotency(ctx, req)
+	if err != nil {
 		return nil, err
 	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	id, err := uuid.NewUUID()
+	if key.key != "" {
+		if profile, err := s.idempotency.get(key, fingerprint); profile != nil || err != nil {
+			return profile, err
+		}
+	}
+	if err := s.checkDuplicates(ctx, req); err != nil {
+		return nil, err
+	}
 
+	profile, err := newProfile(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "unable to generate UUID")
+		return nil, err
 	}
 
-	now := time.Now()
+	revision, err := s.Store.Create(profile)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(api.ProfileEvent_CREATED, profile, revision)
+	if key.key != "" {
+		s.idempotency.put(key, fingerprint, profile)
+	}
+	return profile, nil
+}
 
-	profile := api.Profile{
-		Id:         id.String(),
-		FirstName:  req.FirstName,
-		LastName:   req.LastName,
-		CreateDate: &now,
-		UpdateDate: &now,
+func (s *grpcServer) ReadProfile(ctx context.Context, req *api.ReadProfileReq) (*api.Profile, error) {
+	if req.GetAsOf() != nil {
+		if _, err := s.getAuthorized(ctx, req.GetId(), readAction); err != nil {
+			return nil, err
+		}
+		return s.readAsOf(req)
 	}
 
-	s.profiles = append(s.profiles, &profile)
-	return &profile, nil
+	profile, err := s.resolve(req.GetId())
+	if _, ok := err.(api.ErrProfileNotFound); err != nil && !ok {
+		return nil, err
+	}
+	// Reads redirected to the profile another was merged into are authorized against that profile.
+	object := req.GetId()
+	if profile != nil {
+		object = profile.GetId()
+	}
+	if err := s.authorize(ctx, object, profile.GetOwner(), readAction); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	if profile.IsDeleted() && !req.GetShowDeleted() {
+		return nil, api.ErrProfileNotFound{Id: req.GetId()}
+	}
+	return profile, nil
 }
 
-func (s *grpcServer) ReadProfile(ctx context.Context, req *api.ReadProfileReq) (*api.Profile, error) {
-	if err := s.Authorizer.Authorize(subject(ctx), objectWildcard, readAction); err != nil {
+// Reads the version of a profile that was current at the request's as_of time.
+func (s *grpcServer) readAsOf(req *api.ReadProfileReq) (*api.Profile, error) {
+	versions, err := s.Store.Versions(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	profile, ok := versionAt(versions, *req.GetAsOf())
+	if !ok || profile.IsDeleted() && !req.GetShowDeleted() {
+		return nil, api.ErrProfileNotFound{Id: req.GetId()}
+	}
+	return profile, nil
+}
+
+func (s *grpcServer) UpdateProfile(ctx context.Context, req *api.UpdateProfileReq) (*api.Profile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.updatedProfile(ctx, req)
+	if err != nil {
 		return nil, err
 	}
 
+	revision, err := s.Store.Update(profile)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(api.ProfileEvent_UPDATED, profile, revision)
+	return profile, nil
+}
+
+func (s *grpcServer) UpsertProfile(ctx context.Context, req *api.UpdateProfileReq) (*api.Profile, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	for _, profile := range s.profiles {
-		if profile.GetId() == req.GetId() {
-			return profile, nil
+	current, err := s.Store.Get(req.GetId())
+	if _, ok := err.(api.ErrProfileNotFound); ok {
+		return s.upsertCreate(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, current.GetId(), current.GetOwner(), updateAction); err != nil {
+		return nil, err
+	}
+	if current.IsDeleted() {
+		return nil, api.ErrProfileDeleted{Id: req.GetId()}
+	}
+
+	profile, err := s.updatedProfile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Store.Update(profile)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(api.ProfileEvent_UPDATED, profile, revision)
+	return profile, nil
+}
+
+// Creates the profile an upsert names when there is none. Callers must hold mu.
+func (s *grpcServer) upsertCreate(ctx context.Context, req *api.UpdateProfileReq) (*api.Profile, error) {
+	if err := s.authorize(ctx, objectWildcard, "", createAction); err != nil {
+		return nil, err
+	}
+	if req.GetExpectedRevision() != 0 {
+		return nil, api.ErrRevisionConflict{Id: req.GetId(), Expected: req.GetExpectedRevision()}
+	}
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	// The id the profile is created with is the request's, so it's checked against the rule for ids
+	// and reported as the request's before the profile is given it.
+	for _, violation := range validation.CheckFields(&api.ProfileDto{Id: req.GetId()}) {
+		if violation.Field == "id" {
+			return nil, api.ErrValidation{Violations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "id", Description: violation.Description},
+			}}
 		}
 	}
 
-	return nil, api.ErrProfileNotFound{Id: req.GetId()}
+	// Fields left out of a partial upsert are left empty, and so fail validation.
+	dto := req.GetProfile()
+	if req.IsPartial() {
+		var err error
+		if dto, err = applyUpdateMask(&api.Profile{}, req); err != nil {
+			return nil, err
+		}
+	}
+	withID := *dto
+	withID.Id = req.GetId()
+	dto = &withID
+	if err := s.validateAt("profile.", dto); err != nil {
+		return nil, err
+	}
+	if err := s.checkDuplicates(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	profile, err := newProfile(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Store.Create(profile)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(api.ProfileEvent_CREATED, profile, revision)
+	return profile, nil
 }
 
-func (s *grpcServer) UpdateProfile(ctx context.Context, req *api.UpdateProfileReq) (*api.Profile, error) {
-	if err := s.Authorizer.Authorize(subject(ctx), objectWildcard, updateAction); err != nil {
+func (s *grpcServer) DeleteProfile(ctx context.Context, req *api.DeleteProfileReq) (*api.DeleteProfileRes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.deletedProfile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.Store.Update(profile)
+	if err != nil {
 		return nil, err
 	}
-	panic("implement me")
+	s.publish(api.ProfileEvent_DELETED, profile, revision)
+	return &api.DeleteProfileRes{Success: true}, nil
 }
 
-func (s *grpcServer) DeleteProfile(ctx context.Context, req *api.ReadProfileReq) (*api.DeleteProfileRes, error) {
-	if err := s.Authorizer.Authorize(subject(ctx), objectWildcard, deleteAction); err != nil {
+func (s *grpcServer) UndeleteProfile(ctx context.Context, req *api.ReadProfileReq) (*api.Profile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.getAuthorized(ctx, req.GetId(), deleteAction)
+	if err != nil {
+		return nil, err
+	}
+	if !profile.IsDeleted() {
+		return nil, api.ErrProfileNotDeleted{Id: req.GetId()}
+	}
+	if profile.GetMergedInto() != "" {
+		return nil, api.ErrProfileMerged{Id: req.GetId(), MergedInto: profile.GetMergedInto()}
+	}
+
+	now := time.Now()
+	profile.DeleteDate = nil
+	profile.UpdateDate = &now
+	profile.UpdatedBy = subject(ctx)
+
+	revision, err := s.Store.Update(profile)
+	if err != nil {
 		return nil, err
 	}
-	panic("implement me")
+	// To watchers hiding deleted profiles, an undeleted profile is indistinguishable from a new one.
+	s.publish(api.ProfileEvent_CREATED, profile, revision)
+	return profile, nil
 }
 
-func (s *grpcServer) ListProfiles(ctx context.Context, req *emptypb.Empty) (*api.ListProfilesRes, error) {
-	panic("implement me")
+func (s *grpcServer) PurgeProfile(ctx context.Context, req *api.DeleteProfileReq) (*api.DeleteProfileRes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.getAuthorized(ctx, req.GetId(), purgeAction)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(profile, req.GetExpectedRevision()); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.Store.Delete(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	s.publish(api.ProfileEvent_DELETED, &api.Profile{Id: req.GetId()}, revision)
+	return &api.DeleteProfileRes{Success: true}, nil
+}
+
+func (s *grpcServer) ListProfiles(ctx context.Context, req *api.ListProfilesReq) (*api.ListProfilesRes, error) {
+	if err := s.authorize(ctx, objectWildcard, "", readAction); err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.Store.List()
+	if err != nil {
+		return nil, err
+	}
+	return listPage(profiles, req)
+}
+
+func (s *grpcServer) StreamProfiles(req *api.StreamProfilesReq, stream api.ProfileService_StreamProfilesServer) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, objectWildcard, "", readAction); err != nil {
+		return err
+	}
+
+	order, err := parseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return err
+	}
+	filter, err := parseFilter(req.GetFilter())
+	if err != nil {
+		return err
+	}
+	// Store.List copies the profiles, so the stream sees a consistent snapshot no matter how long the
+	// client takes to consume it.
+	profiles, err := s.Store.List()
+	if err != nil {
+		return err
+	}
+	profiles, err = selectProfiles(profiles, order, filter, req.GetShowDeleted())
+	if err != nil {
+		return err
+	}
+
+	for _, profile := range profiles {
+		// Send blocks while the client's flow control window is full, so a slow consumer holds the
+		// stream back rather than having profiles buffered for it.
+		if err := stream.Send(profile); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+	return nil
+}
+
+func (s *grpcServer) WatchProfiles(req *api.WatchProfilesReq, stream api.ProfileService_WatchProfilesServer) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, objectWildcard, "", readAction); err != nil {
+		return err
+	}
+
+	w, backlog, err := s.hub.subscribe(req.GetResumeRevision())
+	if err != nil {
+		return err
+	}
+	defer s.hub.unsubscribe(w)
+
+	for _, event := range backlog {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event := <-w.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-w.dropped:
+			return status.Error(codes.ResourceExhausted, "watcher fell too far behind, resume from the last revision received")
+		case <-s.Done:
+			return status.Error(codes.Unavailable, "server is stopping, resume from the last revision received")
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+func (s *grpcServer) ListProfileVersions(ctx context.Context, req *api.ListProfileVersionsReq) (*api.ListProfileVersionsRes, error) {
+	if _, err := s.getAuthorized(ctx, req.GetId(), readAction); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.Store.Versions(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return versionPage(versions, req)
+}
+
+// Publishes the event to watchers and brings the search index up to date with it. Callers must
+// hold mu.
+func (s *grpcServer) publish(eventType api.ProfileEvent_Type, profile *api.Profile, revision uint64) {
+	// Purges are published as deletes too.
+	if eventType == api.ProfileEvent_DELETED {
+		s.index.Remove(profile.GetId())
+		s.duplicates.delete(profile.GetId())
+	} else {
+		indexProfile(s.index, profile)
+		s.duplicates.put(profile)
+	}
+	s.hub.publish(&api.ProfileEvent{Type: eventType, Profile: profile, Revision: revision})
+}
+
+// Returns a new profile with the fields of the DTO, and its id or else a generated one.
+func newProfile(ctx context.Context, dto *api.ProfileDto) (*api.Profile, error) {
+	id := dto.GetId()
+	if id == "" {
+		uuid, err := uuid.NewUUID()
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "unable to generate UUID")
+		}
+		id = uuid.String()
+	}
+
+	now := time.Now()
+
+	profile := &api.Profile{
+		Id:         id,
+		CreateDate: &now,
+		UpdateDate: &now,
+		UpdatedBy:  subject(ctx),
+		Owner:      subject(ctx),
+	}
+	setFields(profile, dto)
+	return profile, nil
+}
+
+// Returns the profile as the request would update it, without storing it. Callers must hold mu.
+func (s *grpcServer) updatedProfile(ctx context.Context, req *api.UpdateProfileReq) (*api.Profile, error) {
+	profile, err := s.getLive(ctx, req.GetId(), updateAction)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(profile, req.GetExpectedRevision()); err != nil {
+		return nil, err
+	}
+
+	dto := req.GetProfile()
+	if req.IsPartial() {
+		if dto, err = applyUpdateMask(profile, req); err != nil {
+			return nil, err
+		}
+		if err := s.validateAt("profile.", dto); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	setFields(profile, dto)
+	profile.UpdateDate = &now
+	profile.UpdatedBy = subject(ctx)
+	return profile, nil
+}
+
+// Returns the profile as the request would soft delete it, without storing it. Callers must hold
+// mu.
+func (s *grpcServer) deletedProfile(ctx context.Context, req *api.DeleteProfileReq) (*api.Profile, error) {
+	profile, err := s.getLive(ctx, req.GetId(), deleteAction)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(profile, req.GetExpectedRevision()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	profile.DeleteDate = &now
+	profile.UpdateDate = &now
+	profile.UpdatedBy = subject(ctx)
+	return profile, nil
+}
+
+// Gets a profile once the subject is authorized to perform the action on it. Profiles that don't
+// exist are authorized as if they had no owner, so that subjects can't find out whether profiles
+// they may not access exist.
+func (s *grpcServer) getAuthorized(ctx context.Context, id, action string) (*api.Profile, error) {
+	profile, err := s.Store.Get(id)
+	if _, ok := err.(api.ErrProfileNotFound); err != nil && !ok {
+		return nil, err
+	}
+	if err := s.authorize(ctx, id, profile.GetOwner(), action); err != nil {
+		return nil, err
+	}
+	return profile, err
+}
+
+// Gets a profile as getAuthorized does, treating one that has been soft deleted as not found.
+func (s *grpcServer) getLive(ctx context.Context, id, action string) (*api.Profile, error) {
+	profile, err := s.getAuthorized(ctx, id, action)
+	if err != nil {
+		return nil, err
+	}
+	if profile.IsDeleted() {
+		return nil, api.ErrProfileNotFound{Id: id}
+	}
+	return profile, nil
+}
+
+// Returns api.ErrRevisionConflict unless the profile is at the expected revision, if one was given.
+func checkRevision(profile *api.Profile, expected uint64) error {
+	if expected != 0 && profile.GetRevision() != expected {
+		return api.ErrRevisionConflict{Id: profile.GetId(), Expected: expected, Current: profile.GetRevision()}
+	}
+	return nil
 }
 
 type Authorizer interface {
-	Authorize(subject, object, action string) error
+	// Authorize returns an error unless the subject, or any of the roles it was authenticated
+	// with, is permitted to perform the action on the object: the id of a profile and its owner,
+	// or objectWildcard and no owner for actions on profiles in general such as creating or
+	// listing them.
+	Authorize(subject string, roles []string, object, owner, action string) error
+}
+
+// Authorizes the RPC's subject to perform the action on the object.
+func (s *grpcServer) authorize(ctx context.Context, object, owner, action string) error {
+	return s.Authorizer.Authorize(subject(ctx), roles(ctx), object, owner, action)
 }
 
-// Interceptor that reads the subject out of the client’s cert and writes it to the RPC’s context.
-func authenticate(ctx context.Context) (context.Context, error) {
-	peer, ok := peer.FromContext(ctx)
+// ProfileStore persists profiles on behalf of the RPC handlers. Get, Update and Delete return
+// api.ErrProfileNotFound when no profile exists with the given id.
+//
+// Every mutation is assigned a revision, greater than that of any mutation before it, which is
+// returned by the mutating method and set as the Revision of the profile passed to Create or
+// Update. Revision returns the revision of the latest mutation.
+//
+// Versions returns every version of a profile, oldest first, as it was after each Create and
+// Update, until the profile is deleted.
+//
+// Apply performs several mutations atomically under a single revision, so a profile may only be
+// mutated once by each call.
+type ProfileStore interface {
+	Create(profile *api.Profile) (uint64, error)
+	Get(id string) (*api.Profile, error)
+	Update(profile *api.Profile) (uint64, error)
+	Delete(id string) (uint64, error)
+	List() ([]*api.Profile, error)
+	Versions(id string) ([]*api.Profile, error)
+	Apply(mutations []store.Mutation) (uint64, error)
+	Count() (int, error)
+	Revision() uint64
+}
 
-	if !ok {
-		return ctx, status.New(codes.Unknown, "couldn't find peer info").Err()
+// Interceptor that validates requests with both their generated validators and the configured
+// rules, except for those their handler validates itself.
+func (s *grpcServer) validate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(validation.Message); ok && !selfValidated(req) {
+		if err := s.validateRequest(msg); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// Validates a request with both its generated validators and the configured rules, returning an
+// api.ErrValidation with every field that is invalid.
+func (s *grpcServer) validateRequest(req validation.Message) error {
+	return s.validateAt("", req)
+}
+
+// Validates a message as validateRequest does, naming fields relative to the request at prefix.
+func (s *grpcServer) validateAt(prefix string, msg validation.Message) error {
+	violations := validation.CheckFields(msg)
+	ruleViolations, err := s.checkRules(msg)
+	if err != nil {
+		return err
+	}
+	violations = append(violations, ruleViolations...)
+	if len(violations) == 0 {
+		return nil
 	}
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, len(violations))
+	for i, violation := range violations {
+		fieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       prefix + violation.Field,
+			Description: violation.Description,
+		}
+	}
+	return api.ErrValidation{Violations: fieldViolations}
+}
 
-	if peer.AuthInfo == nil {
-		return ctx, status.New(codes.Unauthenticated, "no transport security being used").Err()
+// Checks the profile a request creates or replaces against the configured rules. Other requests
+// always pass.
+func (s *grpcServer) checkRules(req interface{}) (validation.Violations, error) {
+	if s.Rules == nil {
+		return nil, nil
+	}
+	var dto *api.ProfileDto
+	prefix := ""
+	switch req := req.(type) {
+	case *api.ProfileDto:
+		dto = req
+	case *api.UpdateProfileReq:
+		dto = req.GetProfile()
+		prefix = "profile."
+	default:
+		return nil, nil
+	}
+	err := s.Rules.Validate(dto)
+	if err == nil {
+		return nil, nil
+	}
+	violations, ok := err.(validation.Violations)
+	if !ok {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for i := range violations {
+		violations[i].Field = prefix + violations[i].Field
 	}
+	return violations, nil
+}
 
-	tlsInfo := peer.AuthInfo.(credentials.TLSInfo)
-	subject := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
-	ctx = context.WithValue(ctx, subjectContextKey{}, subject)
-	return ctx, nil
+// Interceptor that validates received messages as validate does requests.
+func (s *grpcServer) validateStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: stream, server: s})
 }
 
-func subject(ctx context.Context) string {
-	return ctx.Value(subjectContextKey{}).(string)
+type validatingStream struct {
+	grpc.ServerStream
+	server *grpcServer
 }
 
-type subjectContextKey struct{}
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(validation.Message); ok && !selfValidated(m) {
+		return s.server.validateRequest(msg)
+	}
+	return nil
+}
+
+// Reports whether the request is validated by its handler rather than the interceptors: partial
+// updates once their update mask has been applied, and batches and imports item by item so that one
+// invalid item doesn't fail the rest.
+func selfValidated(req interface{}) bool {
+	switch req := req.(type) {
+	case *api.UpdateProfileReq:
+		return req.IsPartial()
+	case *api.BatchCreateProfilesReq, *api.BatchUpdateProfilesReq, *api.BatchDeleteProfilesReq, *api.ImportProfilesReq:
+		return true
+	}
+	return false
+}
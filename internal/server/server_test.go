@@ -2,14 +2,22 @@ package server
 
 import (
 	"context"
+	"github.com/gogo/protobuf/types"
 	api "github.com/joshjon/go-profiles/api/v1"
 	"github.com/joshjon/go-profiles/internal/config"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io"
 	"net"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestServerTestSuite(t *testing.T) {
@@ -61,6 +69,878 @@ func (suite *ServerTestSuite) TestCreateReadProfile() {
 	suite.Equal(id, readResponse.Id)
 }
 
+func (suite *ServerTestSuite) TestExtendedProfile() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	payload := &api.ProfileDto{
+		FirstName:    "Foo",
+		LastName:     "Bar",
+		DisplayName:  "Foo Bar",
+		Emails:       []*api.EmailAddress{{Address: "foo@example.com", Label: "work"}},
+		PhoneNumbers: []*api.PhoneNumber{{Number: "+61400000000"}},
+		PostalAddresses: []*api.PostalAddress{{
+			AddressLines: []string{"1 Foo St"},
+			Locality:     "Sydney",
+			RegionCode:   "AU",
+		}},
+		DateOfBirth: "1990-01-31",
+		Locale:      "en-AU",
+		CustomAttributes: map[string]*types.Value{
+			"department": {Kind: &types.Value_StringValue{StringValue: "Engineering"}},
+			"level":      {Kind: &types.Value_NumberValue{NumberValue: 3}},
+		},
+	}
+	created, err := client.CreateProfile(ctx, payload)
+	suite.NoError(err)
+	read, err := client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id})
+	suite.NoError(err)
+	suite.Equal("foo@example.com", read.Emails[0].Address)
+	suite.Equal("AU", read.PostalAddresses[0].RegionCode)
+	suite.Equal("1990-01-31", read.DateOfBirth)
+	suite.Equal("Engineering", read.CustomAttributes["department"].GetStringValue())
+
+	updated, err := client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id: created.Id,
+		Profile: &api.ProfileDto{CustomAttributes: map[string]*types.Value{
+			"level": {Kind: &types.Value_NumberValue{NumberValue: 4}},
+		}},
+		UpdateMask: &types.FieldMask{Paths: []string{"custom_attributes.level", "custom_attributes.department", "locale"}},
+	})
+	suite.NoError(err)
+	suite.Equal(float64(4), updated.CustomAttributes["level"].GetNumberValue())
+	suite.NotContains(updated.CustomAttributes, "department")
+	suite.Empty(updated.Locale)
+	suite.Equal("Foo Bar", updated.DisplayName)
+
+	invalid := []*api.ProfileDto{
+		{FirstName: "Foo", LastName: "Bar", Emails: []*api.EmailAddress{{Address: "foo"}}},
+		{FirstName: "Foo", LastName: "Bar", PhoneNumbers: []*api.PhoneNumber{{Number: "0400 000 000"}}},
+		{FirstName: "Foo", LastName: "Bar", PostalAddresses: []*api.PostalAddress{{RegionCode: "Australia"}}},
+		{FirstName: "Foo", LastName: "Bar", DateOfBirth: "31/01/1990"},
+		{FirstName: "Foo", LastName: "Bar", Locale: "en_AU"},
+		{FirstName: "Foo", LastName: "Bar", DisplayName: strings.Repeat("a", 256)},
+	}
+	for _, dto := range invalid {
+		_, err := client.CreateProfile(ctx, dto)
+		suite.Equal(codes.InvalidArgument, status.Code(err), dto.String())
+	}
+}
+
+func (suite *ServerTestSuite) TestFieldViolations() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	fieldsOf := func(err error) []string {
+		st := status.Convert(err)
+		suite.Equal(codes.InvalidArgument, st.Code())
+		var fields []string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.FieldViolations {
+					fields = append(fields, violation.Field)
+				}
+			}
+		}
+		return fields
+	}
+
+	_, err := client.CreateProfile(ctx, &api.ProfileDto{
+		LastName: "Bar",
+		Emails:   []*api.EmailAddress{{Address: "foo"}},
+		Locale:   "en_AU",
+	})
+	suite.Equal([]string{"first_name", "emails[0].address", "locale"}, fieldsOf(err))
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	_, err = client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:         created.Id,
+		Profile:    &api.ProfileDto{FirstName: "", DateOfBirth: "31/01/1990"},
+		UpdateMask: &types.FieldMask{Paths: []string{"first_name", "date_of_birth"}},
+	})
+	suite.Equal([]string{"profile.first_name", "profile.date_of_birth"}, fieldsOf(err))
+}
+
+func (suite *ServerTestSuite) TestLocalizedErrors() {
+	client := suite.rootClient.Client
+	ctx := metadata.AppendToOutgoingContext(context.Background(), api.AcceptLanguageHeader, "es-MX, en;q=0.5")
+
+	localized := func(err error) *errdetails.LocalizedMessage {
+		for _, detail := range status.Convert(err).Details() {
+			if msg, ok := detail.(*errdetails.LocalizedMessage); ok {
+				return msg
+			}
+		}
+		return nil
+	}
+
+	_, err := client.ReadProfile(ctx, &api.ReadProfileReq{Id: "missing"})
+	suite.Equal("es", localized(err).Locale)
+	suite.Equal("El perfil solicitado no existe: missing", localized(err).Message)
+	decoded, ok := api.FromError(err)
+	suite.True(ok)
+	suite.Equal(api.ErrProfileNotFound{Id: "missing"}, decoded)
+
+	_, err = suite.nobodyClient.Client.ReadProfile(ctx, &api.ReadProfileReq{Id: "missing"})
+	decoded, ok = api.FromError(err)
+	suite.True(ok)
+	suite.IsType(api.ErrPermissionDenied{}, decoded)
+
+	// Errors nested in responses are localized too.
+	res, err := client.BatchDeleteProfiles(ctx, &api.BatchDeleteProfilesReq{
+		Requests: []*api.DeleteProfileReq{{Id: "missing"}},
+	})
+	suite.NoError(err)
+	suite.Equal(int32(codes.NotFound), res.Results[0].Status.Code)
+	msg := &errdetails.LocalizedMessage{}
+	for _, detail := range res.Results[0].Status.Details {
+		if detail.TypeUrl == "type.googleapis.com/google.rpc.LocalizedMessage" {
+			suite.NoError(proto.Unmarshal(detail.Value, msg))
+		}
+	}
+	suite.Equal("es", msg.Locale)
+}
+
+func (suite *ServerTestSuite) TestCreateProfileIdempotent() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar", IdempotencyKey: "foo"})
+	suite.NoError(err)
+	replayed, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar", IdempotencyKey: "foo"})
+	suite.NoError(err)
+	suite.Equal(created.Id, replayed.Id)
+
+	// The key can be sent as a header instead.
+	headerCtx := metadata.AppendToOutgoingContext(ctx, "idempotency-key", "foo")
+	replayed, err = client.CreateProfile(headerCtx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	suite.Equal(created.Id, replayed.Id)
+
+	_, err = client.CreateProfile(headerCtx, &api.ProfileDto{FirstName: "Baz", LastName: "Bar"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = client.CreateProfile(headerCtx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar", IdempotencyKey: "bar"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	other, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar", IdempotencyKey: "bar"})
+	suite.NoError(err)
+	suite.NotEqual(created.Id, other.Id)
+	res, err := client.ListProfiles(ctx, &api.ListProfilesReq{})
+	suite.NoError(err)
+	suite.Equal(int32(2), res.TotalSize)
+}
+
+func (suite *ServerTestSuite) TestCreateProfileWithId() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{Id: "emp-42", FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	suite.Equal("emp-42", created.Id)
+
+	_, err = client.CreateProfile(ctx, &api.ProfileDto{Id: "emp-42", FirstName: "Baz", LastName: "Bar"})
+	suite.Equal(codes.AlreadyExists, status.Code(err))
+	_, err = client.CreateProfile(ctx, &api.ProfileDto{Id: "emp 42", FirstName: "Baz", LastName: "Bar"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestUpsertProfile() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.UpsertProfile(ctx, &api.UpdateProfileReq{Id: "emp-42", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}})
+	suite.NoError(err)
+	suite.Equal("emp-42", created.Id)
+
+	replaced, err := client.UpsertProfile(ctx, &api.UpdateProfileReq{
+		Id:               "emp-42",
+		Profile:          &api.ProfileDto{FirstName: "Baz"},
+		UpdateMask:       &types.FieldMask{Paths: []string{"first_name"}},
+		ExpectedRevision: created.Revision,
+	})
+	suite.NoError(err)
+	suite.Equal("Baz", replaced.FirstName)
+	suite.Equal("Bar", replaced.LastName)
+	suite.Equal(created.CreateDate, replaced.CreateDate)
+
+	scenarios := []struct {
+		scenario string
+		req      *api.UpdateProfileReq
+		code     codes.Code
+	}{
+		{
+			scenario: "stale revision",
+			req:      &api.UpdateProfileReq{Id: "emp-42", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}, ExpectedRevision: created.Revision},
+			code:     codes.Aborted,
+		},
+		{
+			scenario: "revision of missing profile",
+			req:      &api.UpdateProfileReq{Id: "emp-43", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}, ExpectedRevision: 1},
+			code:     codes.Aborted,
+		},
+		{
+			scenario: "partial create",
+			req: &api.UpdateProfileReq{
+				Id:         "emp-43",
+				Profile:    &api.ProfileDto{FirstName: "Foo"},
+				UpdateMask: &types.FieldMask{Paths: []string{"first_name"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			scenario: "missing id",
+			req:      &api.UpdateProfileReq{Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}},
+			code:     codes.InvalidArgument,
+		},
+		{
+			scenario: "invalid id",
+			req:      &api.UpdateProfileReq{Id: "emp/43", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}},
+			code:     codes.InvalidArgument,
+		},
+	}
+	for _, tc := range scenarios {
+		_, err := client.UpsertProfile(ctx, tc.req)
+		suite.Equal(tc.code, status.Code(err), "scenario: "+tc.scenario)
+	}
+
+	// The id is reported as the request's, not the profile's.
+	_, err = client.UpsertProfile(ctx, &api.UpdateProfileReq{Id: "emp/43", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}})
+	apiErr, ok := api.FromError(err)
+	suite.True(ok)
+	suite.Len(apiErr.(api.ErrValidation).Violations, 1)
+	suite.Equal("id", apiErr.(api.ErrValidation).Violations[0].Field)
+
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: "emp-42"})
+	suite.NoError(err)
+	_, err = client.UpsertProfile(ctx, &api.UpdateProfileReq{Id: "emp-42", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = suite.nobodyClient.Client.UpsertProfile(ctx, &api.UpdateProfileReq{Id: "emp-43", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestUpdateProfile() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+
+	updated, err := client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:      created.Id,
+		Profile: &api.ProfileDto{FirstName: "Baz", LastName: "Qux"},
+	})
+	suite.NoError(err)
+	suite.Equal("Baz", updated.FirstName)
+	suite.Equal("Qux", updated.LastName)
+	suite.Equal(created.CreateDate, updated.CreateDate)
+	suite.True(updated.UpdateDate.After(*created.UpdateDate))
+
+	// Only the masked field changes, and the omitted last name doesn't fail validation.
+	updated, err = client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:         created.Id,
+		Profile:    &api.ProfileDto{FirstName: "Quux"},
+		UpdateMask: &types.FieldMask{Paths: []string{"first_name"}},
+	})
+	suite.NoError(err)
+	suite.Equal("Quux", updated.FirstName)
+	suite.Equal("Qux", updated.LastName)
+
+	read, err := client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id})
+	suite.NoError(err)
+	suite.Equal(updated.FirstName, read.FirstName)
+}
+
+func (suite *ServerTestSuite) TestUpdateProfileInvalid() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+
+	testCases := []struct {
+		scenario string
+		req      *api.UpdateProfileReq
+		code     codes.Code
+	}{
+		{
+			scenario: "not found",
+			req:      &api.UpdateProfileReq{Id: "foo", Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}},
+			code:     codes.NotFound,
+		},
+		{
+			scenario: "missing field",
+			req:      &api.UpdateProfileReq{Id: created.Id, Profile: &api.ProfileDto{FirstName: "Foo"}},
+			code:     codes.InvalidArgument,
+		},
+		{
+			scenario: "masked field empty",
+			req: &api.UpdateProfileReq{
+				Id:         created.Id,
+				Profile:    &api.ProfileDto{LastName: "Bar"},
+				UpdateMask: &types.FieldMask{Paths: []string{"first_name"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			scenario: "unknown mask path",
+			req: &api.UpdateProfileReq{
+				Id:         created.Id,
+				Profile:    &api.ProfileDto{FirstName: "Foo"},
+				UpdateMask: &types.FieldMask{Paths: []string{"id"}},
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := client.UpdateProfile(ctx, tc.req)
+		suite.Equal(tc.code, status.Code(err), "scenario: "+tc.scenario)
+	}
+}
+
+func (suite *ServerTestSuite) TestDeleteUndeletePurgeProfile() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+
+	deleted, err := client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id})
+	suite.NoError(err)
+	suite.True(deleted.Success)
+
+	// Deleted profiles are hidden unless explicitly asked for.
+	_, err = client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id})
+	suite.Equal(codes.NotFound, status.Code(err))
+	tombstone, err := client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id, ShowDeleted: true})
+	suite.NoError(err)
+	suite.NotNil(tombstone.DeleteDate)
+
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	undeleted, err := client.UndeleteProfile(ctx, &api.ReadProfileReq{Id: created.Id})
+	suite.NoError(err)
+	suite.Nil(undeleted.DeleteDate)
+	_, err = client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id})
+	suite.NoError(err)
+
+	_, err = client.UndeleteProfile(ctx, &api.ReadProfileReq{Id: created.Id})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	purged, err := client.PurgeProfile(ctx, &api.DeleteProfileReq{Id: created.Id})
+	suite.NoError(err)
+	suite.True(purged.Success)
+	_, err = client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id, ShowDeleted: true})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestListProfiles() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	for _, lastName := range []string{"D", "B", "E", "A", "C"} {
+		_, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: lastName})
+		suite.NoError(err)
+	}
+	deleted, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "F"})
+	suite.NoError(err)
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: deleted.Id})
+	suite.NoError(err)
+
+	req := &api.ListProfilesReq{PageSize: 2, OrderBy: "last_name"}
+	var lastNames []string
+	var totalSizes []int32
+	for {
+		res, err := client.ListProfiles(ctx, req)
+		suite.NoError(err)
+		totalSizes = append(totalSizes, res.TotalSize)
+		for _, profile := range res.Profiles {
+			lastNames = append(lastNames, profile.LastName)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		if len(lastNames) == 2 {
+			// Profiles inserted mid-listing before the token don't shift the following pages.
+			_, err = client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "AA"})
+			suite.NoError(err)
+		}
+		req.PageToken = res.NextPageToken
+	}
+	suite.Equal([]string{"A", "B", "C", "D", "E"}, lastNames)
+	suite.Equal([]int32{5, 6, 6}, totalSizes)
+
+	res, err := client.ListProfiles(ctx, &api.ListProfilesReq{OrderBy: "last_name desc", ShowDeleted: true})
+	suite.NoError(err)
+	suite.Equal(int32(7), res.TotalSize)
+	suite.Equal("F", res.Profiles[0].LastName)
+
+	res, err = client.ListProfiles(ctx, &api.ListProfilesReq{
+		Filter: "last_name in ('A', 'E') && create_date > '2000-01-01'",
+	})
+	suite.NoError(err)
+	suite.Len(res.Profiles, 2)
+	suite.Equal("E", res.Profiles[0].LastName)
+	suite.Equal("A", res.Profiles[1].LastName)
+}
+
+func (suite *ServerTestSuite) TestListProfilesInvalid() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	_, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	_, err = client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Baz"})
+	suite.NoError(err)
+	res, err := client.ListProfiles(ctx, &api.ListProfilesReq{PageSize: 1})
+	suite.NoError(err)
+
+	testCases := []struct {
+		scenario string
+		req      *api.ListProfilesReq
+	}{
+		{scenario: "negative page size", req: &api.ListProfilesReq{PageSize: -1}},
+		{scenario: "unknown order field", req: &api.ListProfilesReq{OrderBy: "first_name"}},
+		{scenario: "bad filter syntax", req: &api.ListProfilesReq{Filter: "last_name =="}},
+		{scenario: "non-boolean filter", req: &api.ListProfilesReq{Filter: "last_name"}},
+		{scenario: "garbage token", req: &api.ListProfilesReq{PageToken: "foo"}},
+		{scenario: "token for other order", req: &api.ListProfilesReq{PageToken: res.NextPageToken, OrderBy: "last_name"}},
+	}
+	for _, tc := range testCases {
+		_, err := client.ListProfiles(ctx, tc.req)
+		suite.Equal(codes.InvalidArgument, status.Code(err), "scenario: "+tc.scenario)
+	}
+}
+
+func (suite *ServerTestSuite) TestStreamProfiles() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	for _, lastName := range []string{"C", "A", "B"} {
+		_, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: lastName})
+		suite.NoError(err)
+	}
+
+	stream, err := client.StreamProfiles(ctx, &api.StreamProfilesReq{OrderBy: "last_name", Filter: "last_name != 'B'"})
+	suite.NoError(err)
+	var lastNames []string
+	for {
+		profile, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		suite.NoError(err)
+		lastNames = append(lastNames, profile.LastName)
+	}
+	suite.Equal([]string{"A", "C"}, lastNames)
+
+	stream, err = suite.nobodyClient.Client.StreamProfiles(ctx, &api.StreamProfilesReq{})
+	suite.NoError(err)
+	_, err = stream.Recv()
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestWatchProfiles() {
+	client := suite.rootClient.Client
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	_, err = client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:      created.Id,
+		Profile: &api.ProfileDto{FirstName: "Baz", LastName: "Bar"},
+	})
+	suite.NoError(err)
+
+	// Resuming after the create replays the update, after which new events follow.
+	stream, err := client.WatchProfiles(ctx, &api.WatchProfilesReq{ResumeRevision: 1})
+	suite.NoError(err)
+	event, err := stream.Recv()
+	suite.NoError(err)
+	suite.Equal(api.ProfileEvent_UPDATED, event.Type)
+	suite.Equal(uint64(2), event.Revision)
+	suite.Equal("Baz", event.Profile.FirstName)
+
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id})
+	suite.NoError(err)
+	event, err = stream.Recv()
+	suite.NoError(err)
+	suite.Equal(api.ProfileEvent_DELETED, event.Type)
+	suite.Equal(uint64(3), event.Revision)
+	suite.Equal(created.Id, event.Profile.Id)
+
+	stream, err = suite.nobodyClient.Client.WatchProfiles(ctx, &api.WatchProfilesReq{})
+	suite.NoError(err)
+	_, err = stream.Recv()
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestRevisionConflict() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	suite.NotZero(created.Revision)
+
+	updated, err := client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:               created.Id,
+		Profile:          &api.ProfileDto{FirstName: "Baz", LastName: "Bar"},
+		ExpectedRevision: created.Revision,
+	})
+	suite.NoError(err)
+	suite.True(updated.Revision > created.Revision)
+
+	// A second writer still holding the original revision loses.
+	_, err = client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:               created.Id,
+		Profile:          &api.ProfileDto{FirstName: "Qux", LastName: "Bar"},
+		ExpectedRevision: created.Revision,
+	})
+	st := status.Convert(err)
+	suite.Equal(codes.Aborted, st.Code())
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = d
+		}
+	}
+	suite.NotNil(info)
+	suite.Equal(strconv.FormatUint(updated.Revision, 10), info.Metadata["current_revision"])
+
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id, ExpectedRevision: created.Revision})
+	suite.Equal(codes.Aborted, status.Code(err))
+	_, err = client.PurgeProfile(ctx, &api.DeleteProfileReq{Id: created.Id, ExpectedRevision: created.Revision})
+	suite.Equal(codes.Aborted, status.Code(err))
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id, ExpectedRevision: updated.Revision})
+	suite.NoError(err)
+}
+
+func (suite *ServerTestSuite) TestProfileVersions() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	created, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Foo", LastName: "Bar"})
+	suite.NoError(err)
+	suite.Equal("root", created.UpdatedBy)
+	for _, firstName := range []string{"Baz", "Qux"} {
+		_, err = client.UpdateProfile(ctx, &api.UpdateProfileReq{
+			Id:      created.Id,
+			Profile: &api.ProfileDto{FirstName: firstName, LastName: "Bar"},
+		})
+		suite.NoError(err)
+	}
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: created.Id})
+	suite.NoError(err)
+
+	var firstNames []string
+	req := &api.ListProfileVersionsReq{Id: created.Id, PageSize: 3}
+	for {
+		res, err := client.ListProfileVersions(ctx, req)
+		suite.NoError(err)
+		for _, version := range res.Versions {
+			firstNames = append(firstNames, version.FirstName)
+			suite.Equal("root", version.UpdatedBy)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	suite.Equal([]string{"Qux", "Qux", "Baz", "Foo"}, firstNames)
+
+	asOf := *created.UpdateDate
+	read, err := client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id, AsOf: &asOf})
+	suite.NoError(err)
+	suite.Equal("Foo", read.FirstName)
+	suite.Equal(created.Revision, read.Revision)
+
+	// The profile didn't exist yet, and is deleted now.
+	asOf = created.UpdateDate.Add(-time.Nanosecond)
+	_, err = client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id, AsOf: &asOf})
+	suite.Equal(codes.NotFound, status.Code(err))
+	asOf = time.Now()
+	_, err = client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id, AsOf: &asOf})
+	suite.Equal(codes.NotFound, status.Code(err))
+	read, err = client.ReadProfile(ctx, &api.ReadProfileReq{Id: created.Id, AsOf: &asOf, ShowDeleted: true})
+	suite.NoError(err)
+	suite.True(read.IsDeleted())
+
+	_, err = client.ListProfileVersions(ctx, &api.ListProfileVersionsReq{Id: created.Id, PageToken: "foo"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = client.ListProfileVersions(ctx, &api.ListProfileVersionsReq{Id: "foo"})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.nobodyClient.Client.ListProfileVersions(ctx, &api.ListProfileVersionsReq{Id: created.Id})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestBatchProfiles() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	codesOf := func(res *api.BatchProfilesRes) []codes.Code {
+		var statusCodes []codes.Code
+		for _, result := range res.Results {
+			statusCodes = append(statusCodes, codes.Code(result.Status.Code))
+		}
+		return statusCodes
+	}
+	count := func() int32 {
+		res, err := client.ListProfiles(ctx, &api.ListProfilesReq{})
+		suite.NoError(err)
+		return res.TotalSize
+	}
+
+	// An invalid item fails an all or nothing batch, and is reported with the others left unapplied.
+	res, err := client.BatchCreateProfiles(ctx, &api.BatchCreateProfilesReq{Profiles: []*api.ProfileDto{
+		{FirstName: "Foo", LastName: "Bar"},
+		{FirstName: "Foo"},
+	}})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.Aborted, codes.InvalidArgument}, codesOf(res))
+	suite.Zero(count())
+
+	res, err = client.BatchCreateProfiles(ctx, &api.BatchCreateProfilesReq{
+		Profiles: []*api.ProfileDto{{FirstName: "Foo", LastName: "Bar"}, {FirstName: "Foo"}},
+		Mode:     api.BatchMode_BEST_EFFORT,
+	})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.OK, codes.InvalidArgument}, codesOf(res))
+	suite.Equal(int32(1), count())
+	first := res.Results[0].Profile
+
+	res, err = client.BatchCreateProfiles(ctx, &api.BatchCreateProfilesReq{Profiles: []*api.ProfileDto{
+		{FirstName: "Baz", LastName: "Bar"},
+		{FirstName: "Qux", LastName: "Bar"},
+	}})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.OK, codes.OK}, codesOf(res))
+	suite.Equal(res.Results[0].Profile.Revision, res.Results[1].Profile.Revision)
+	second, third := res.Results[0].Profile, res.Results[1].Profile
+
+	res, err = client.BatchUpdateProfiles(ctx, &api.BatchUpdateProfilesReq{Requests: []*api.UpdateProfileReq{
+		{Id: first.Id, Profile: &api.ProfileDto{FirstName: "Quux"}, UpdateMask: &types.FieldMask{Paths: []string{"first_name"}}},
+		{Id: second.Id, Profile: &api.ProfileDto{FirstName: "Quux", LastName: "Bar"}, ExpectedRevision: first.Revision},
+		{Id: "foo", Profile: &api.ProfileDto{FirstName: "Quux", LastName: "Bar"}},
+	}})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.Aborted, codes.Aborted, codes.NotFound}, codesOf(res))
+	info := &errdetails.ErrorInfo{}
+	for _, detail := range res.Results[1].Status.Details {
+		if detail.TypeUrl == "type.googleapis.com/google.rpc.ErrorInfo" {
+			suite.NoError(proto.Unmarshal(detail.Value, info))
+		}
+	}
+	suite.Equal("REVISION_CONFLICT", info.GetReason())
+
+	res, err = client.BatchUpdateProfiles(ctx, &api.BatchUpdateProfilesReq{Requests: []*api.UpdateProfileReq{
+		{Id: first.Id, Profile: &api.ProfileDto{FirstName: "Quux"}, UpdateMask: &types.FieldMask{Paths: []string{"first_name"}}},
+		{Id: second.Id, Profile: &api.ProfileDto{FirstName: "Quux", LastName: "Bar"}, ExpectedRevision: second.Revision},
+	}})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.OK, codes.OK}, codesOf(res))
+	suite.Equal("Quux", res.Results[0].Profile.FirstName)
+	suite.Equal("Bar", res.Results[0].Profile.LastName)
+
+	res, err = client.BatchDeleteProfiles(ctx, &api.BatchDeleteProfilesReq{
+		Requests: []*api.DeleteProfileReq{{Id: first.Id}, {Id: third.Id}, {Id: third.Id}},
+		Mode:     api.BatchMode_BEST_EFFORT,
+	})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.OK, codes.OK, codes.NotFound}, codesOf(res))
+	suite.True(res.Results[0].Profile.IsDeleted())
+	suite.Equal(int32(1), count())
+
+	// Items are authorized individually, as the profiles they're for may be owned by the subject.
+	res, err = suite.nobodyClient.Client.BatchDeleteProfiles(ctx, &api.BatchDeleteProfilesReq{
+		Requests: []*api.DeleteProfileReq{{Id: second.Id}},
+	})
+	suite.NoError(err)
+	suite.Equal([]codes.Code{codes.PermissionDenied}, codesOf(res))
+
+	reqs := make([]*api.DeleteProfileReq, maxBatchSize+1)
+	for i := range reqs {
+		reqs[i] = &api.DeleteProfileReq{Id: second.Id}
+	}
+	_, err = client.BatchDeleteProfiles(ctx, &api.BatchDeleteProfilesReq{Requests: reqs})
+	apiErr, ok := api.FromError(err)
+	suite.True(ok)
+	suite.Equal(api.ErrQuotaExceeded{Quota: "batch_size", Limit: maxBatchSize}, apiErr)
+	suite.Equal(codes.ResourceExhausted, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestImportProfiles() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	stream, err := client.ImportProfiles(ctx)
+	suite.NoError(err)
+	suite.NoError(stream.Send(&api.ImportProfilesReq{ImportId: "foo"}))
+	progress, err := stream.Recv()
+	suite.NoError(err)
+	suite.Zero(progress.NextSequence)
+
+	// The import can only be streamed by one client at a time.
+	other, err := client.ImportProfiles(ctx)
+	suite.NoError(err)
+	suite.NoError(other.Send(&api.ImportProfilesReq{ImportId: "foo"}))
+	_, err = other.Recv()
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	for i, dto := range []*api.ProfileDto{{FirstName: "Foo", LastName: "Bar"}, {FirstName: "Foo"}, {FirstName: "Baz", LastName: "Bar"}} {
+		suite.NoError(stream.Send(&api.ImportProfilesReq{Sequence: uint64(i), Profile: dto}))
+	}
+	suite.NoError(stream.CloseSend())
+	progress, err = stream.Recv()
+	suite.NoError(err)
+	suite.Equal(uint64(3), progress.NextSequence)
+	suite.Equal(uint64(2), progress.Accepted)
+	suite.Equal(uint64(1), progress.Rejected)
+	suite.Len(progress.Errors, 1)
+	suite.Equal(uint64(1), progress.Errors[0].Sequence)
+	suite.Equal(int32(codes.InvalidArgument), progress.Errors[0].Status.Code)
+	_, err = stream.Recv()
+	suite.Equal(io.EOF, err)
+
+	// Resuming skips records that were already imported.
+	stream, err = client.ImportProfiles(ctx)
+	suite.NoError(err)
+	suite.NoError(stream.Send(&api.ImportProfilesReq{ImportId: "foo"}))
+	progress, err = stream.Recv()
+	suite.NoError(err)
+	suite.Equal(uint64(3), progress.NextSequence)
+	suite.NoError(stream.Send(&api.ImportProfilesReq{Sequence: 2, Profile: &api.ProfileDto{FirstName: "Baz", LastName: "Bar"}}))
+	suite.NoError(stream.Send(&api.ImportProfilesReq{Sequence: 3, Profile: &api.ProfileDto{FirstName: "Qux", LastName: "Bar"}}))
+	suite.NoError(stream.CloseSend())
+	progress, err = stream.Recv()
+	suite.NoError(err)
+	suite.Equal(uint64(4), progress.NextSequence)
+	suite.Equal(uint64(3), progress.Accepted)
+	suite.Empty(progress.Errors)
+
+	res, err := client.ListProfiles(ctx, &api.ListProfilesReq{})
+	suite.NoError(err)
+	suite.Equal(int32(3), res.TotalSize)
+
+	// Records can't be skipped.
+	stream, err = client.ImportProfiles(ctx)
+	suite.NoError(err)
+	suite.NoError(stream.Send(&api.ImportProfilesReq{ImportId: "foo", Sequence: 5, Profile: &api.ProfileDto{FirstName: "Foo", LastName: "Bar"}}))
+	_, err = stream.Recv()
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	stream, err = suite.nobodyClient.Client.ImportProfiles(ctx)
+	suite.NoError(err)
+	_, err = stream.Recv()
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestSearchProfiles() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	jose, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "José", LastName: "Núñez"})
+	suite.NoError(err)
+	joseph, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Joseph", LastName: "Smith"})
+	suite.NoError(err)
+
+	search := func(query string) []string {
+		res, err := client.SearchProfiles(ctx, &api.SearchProfilesReq{Query: query})
+		suite.NoError(err)
+		var ids []string
+		for _, result := range res.Results {
+			ids = append(ids, result.Profile.Id)
+		}
+		return ids
+	}
+	suite.Equal([]string{jose.Id, joseph.Id}, search("jose"))
+	suite.Equal([]string{jose.Id}, search("Jose Nunez"))
+	suite.Equal([]string{joseph.Id}, search("jsoeph smtih"))
+
+	// The index follows updates and deletes.
+	_, err = client.UpdateProfile(ctx, &api.UpdateProfileReq{
+		Id:         jose.Id,
+		Profile:    &api.ProfileDto{LastName: "Smith"},
+		UpdateMask: &types.FieldMask{Paths: []string{"last_name"}},
+	})
+	suite.NoError(err)
+	suite.Empty(search("nunez"))
+	suite.Equal([]string{jose.Id, joseph.Id}, search("smith"))
+	_, err = client.DeleteProfile(ctx, &api.DeleteProfileReq{Id: jose.Id})
+	suite.NoError(err)
+	suite.Equal([]string{joseph.Id}, search("smith"))
+	_, err = client.UndeleteProfile(ctx, &api.ReadProfileReq{Id: jose.Id})
+	suite.NoError(err)
+	suite.Len(search("smith"), 2)
+
+	_, err = client.SearchProfiles(ctx, &api.SearchProfilesReq{})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = suite.nobodyClient.Client.SearchProfiles(ctx, &api.SearchProfilesReq{Query: "smith"})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestMergeProfiles() {
+	client := suite.rootClient.Client
+	ctx := context.Background()
+
+	survivor, err := client.CreateProfile(ctx, &api.ProfileDto{
+		FirstName: "Foo",
+		LastName:  "Bar",
+		Emails:    []*api.EmailAddress{{Address: "foo@example.com"}},
+	})
+	suite.NoError(err)
+	merged, err := client.CreateProfile(ctx, &api.ProfileDto{
+		FirstName:   "foo",
+		LastName:    "BAR",
+		DisplayName: "Foo Bar",
+		Emails:      []*api.EmailAddress{{Address: "FOO@example.com"}, {Address: "bar@example.com"}},
+	})
+	suite.NoError(err)
+	_, err = client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Baz", LastName: "Bar"})
+	suite.NoError(err)
+
+	res, err := client.FindDuplicates(ctx, &api.FindDuplicatesReq{})
+	suite.NoError(err)
+	suite.Len(res.Clusters, 1)
+	suite.Equal("foo bar", res.Clusters[0].Name)
+	suite.Equal(survivor.Id, res.Clusters[0].Profiles[0].Id)
+	suite.Equal(merged.Id, res.Clusters[0].Profiles[1].Id)
+
+	result, err := client.MergeProfiles(ctx, &api.MergeProfilesReq{
+		SurvivorId:             survivor.Id,
+		MergedId:               merged.Id,
+		ExpectedMergedRevision: merged.Revision,
+	})
+	suite.NoError(err)
+	suite.Equal("Foo", result.FirstName)
+	suite.Equal("Foo Bar", result.DisplayName)
+	suite.Len(result.Emails, 2)
+
+	// Reads of the merged profile are redirected to the survivor.
+	read, err := client.ReadProfile(ctx, &api.ReadProfileReq{Id: merged.Id})
+	suite.NoError(err)
+	suite.Equal(survivor.Id, read.Id)
+	suite.Equal(result.Revision, read.Revision)
+	_, err = client.UndeleteProfile(ctx, &api.ReadProfileReq{Id: merged.Id})
+	decoded, _ := api.FromError(err)
+	suite.Equal(api.ErrProfileMerged{Id: merged.Id, MergedInto: survivor.Id}, decoded)
+
+	res, err = client.FindDuplicates(ctx, &api.FindDuplicatesReq{})
+	suite.NoError(err)
+	suite.Empty(res.Clusters)
+
+	_, err = client.MergeProfiles(ctx, &api.MergeProfilesReq{SurvivorId: survivor.Id, MergedId: merged.Id})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = client.MergeProfiles(ctx, &api.MergeProfilesReq{SurvivorId: survivor.Id, MergedId: survivor.Id})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = suite.nobodyClient.Client.MergeProfiles(ctx, &api.MergeProfilesReq{SurvivorId: survivor.Id, MergedId: merged.Id})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
 func (suite *ServerTestSuite) TestProfileNotFound() {
 	ctx := context.Background()
 	client := suite.rootClient.Client
@@ -84,4 +964,9 @@ func (suite *ServerTestSuite) TestUnauthorized() {
 	suite.Nil(readResponse)
 	code, expectedCode = status.Code(err), codes.PermissionDenied
 	suite.Equal(code, expectedCode)
+
+	listResponse, err := client.ListProfiles(ctx, &api.ListProfilesReq{})
+	suite.Nil(listResponse)
+	code, expectedCode = status.Code(err), codes.PermissionDenied
+	suite.Equal(code, expectedCode)
 }
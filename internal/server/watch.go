@@ -0,0 +1,98 @@
+package server
+
+import (
+	"sync"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultWatchHistory = 1000
+	// watchBuffer is how many events a watcher may fall behind by before it is dropped.
+	watchBuffer = 256
+)
+
+// watchHub fans profile events out to watchers and retains the most recent ones so that watchers
+// can resume from a revision they've already seen.
+type watchHub struct {
+	mu       sync.Mutex
+	history  []*api.ProfileEvent
+	capacity int
+	// compacted is the highest revision no longer in history. Watchers can only resume after it.
+	compacted uint64
+	watchers  map[*watcher]struct{}
+}
+
+type watcher struct {
+	events chan *api.ProfileEvent
+	// dropped is closed when the watcher fell too far behind and was unsubscribed.
+	dropped chan struct{}
+}
+
+func newWatchHub(revision uint64, capacity int) *watchHub {
+	if capacity <= 0 {
+		capacity = defaultWatchHistory
+	}
+	return &watchHub{
+		capacity:  capacity,
+		compacted: revision,
+		watchers:  make(map[*watcher]struct{}),
+	}
+}
+
+// publish records the event and sends it to every watcher. Events must be published in revision
+// order.
+func (h *watchHub) publish(event *api.ProfileEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.history) == h.capacity {
+		h.compacted = h.history[0].GetRevision()
+		h.history = append(h.history[:0], h.history[1:]...)
+	}
+	h.history = append(h.history, event)
+
+	for w := range h.watchers {
+		select {
+		case w.events <- event:
+		default:
+			delete(h.watchers, w)
+			close(w.dropped)
+		}
+	}
+}
+
+// subscribe registers a watcher and returns the retained events after resumeRevision, which the
+// watcher must handle before anything it receives.
+func (h *watchHub) subscribe(resumeRevision uint64) (*watcher, []*api.ProfileEvent, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var backlog []*api.ProfileEvent
+	if resumeRevision > 0 {
+		if resumeRevision < h.compacted {
+			return nil, nil, status.Errorf(codes.OutOfRange,
+				"revision %d is no longer retained, resume from %d or later", resumeRevision, h.compacted)
+		}
+		for _, event := range h.history {
+			if event.GetRevision() > resumeRevision {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	w := &watcher{
+		events:  make(chan *api.ProfileEvent, watchBuffer),
+		dropped: make(chan struct{}),
+	}
+	h.watchers[w] = struct{}{}
+	return w, backlog, nil
+}
+
+func (h *watchHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.watchers, w)
+}
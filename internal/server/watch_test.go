@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchHubResume(t *testing.T) {
+	hub := newWatchHub(10, 3)
+	for revision := uint64(11); revision <= 15; revision++ {
+		hub.publish(&api.ProfileEvent{Revision: revision})
+	}
+
+	// Only 13 to 15 are retained, so a watcher must have seen at least 12 to resume.
+	_, _, err := hub.subscribe(11)
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	w, backlog, err := hub.subscribe(12)
+	require.NoError(t, err)
+	require.Len(t, backlog, 3)
+	require.Equal(t, uint64(13), backlog[0].Revision)
+
+	hub.publish(&api.ProfileEvent{Revision: 16})
+	require.Equal(t, uint64(16), (<-w.events).Revision)
+}
+
+func TestWatchHubDropsSlowWatcher(t *testing.T) {
+	hub := newWatchHub(0, 0)
+	w, _, err := hub.subscribe(0)
+	require.NoError(t, err)
+
+	for revision := uint64(1); revision <= watchBuffer+1; revision++ {
+		hub.publish(&api.ProfileEvent{Revision: revision})
+	}
+	<-w.dropped
+	require.Len(t, w.events, watchBuffer)
+	require.Empty(t, hub.watchers)
+}
+
+func TestGracefulStopEndsWatches(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	done := make(chan struct{})
+	gsrv := NewGRPCServer(&Config{
+		Authorizer:     allowAll{},
+		Authenticators: []Authenticator{staticAuthenticator{Subject: "root"}},
+		Done:           done,
+	})
+	go gsrv.Serve(ln)
+
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := api.NewProfileServiceClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var created []*api.Profile
+	for i := 0; i < 2; i++ {
+		profile, err := client.CreateProfile(ctx, &api.ProfileDto{FirstName: "Jo", LastName: "Smith"})
+		require.NoError(t, err)
+		created = append(created, profile)
+	}
+	// The watch is only certain to be established once it has received an event, so it resumes
+	// from before the second profile was created.
+	stream, err := client.WatchProfiles(ctx, &api.WatchProfilesReq{ResumeRevision: created[0].Revision})
+	require.NoError(t, err)
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, created[1].Revision, event.Revision)
+
+	stopped := make(chan struct{})
+	go func() {
+		close(done)
+		gsrv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("graceful stop waited on the watch")
+	}
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
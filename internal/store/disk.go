@@ -0,0 +1,241 @@
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/wal"
+)
+
+type DiskConfig struct {
+	WAL wal.Config
+	// CompactInterval is how often the write-ahead log is compacted into a snapshot of the current
+	// profiles. Compaction is disabled when zero.
+	CompactInterval time.Duration
+}
+
+// Disk is a durable ProfileStore. Every mutation is appended to a segmented write-ahead log in the
+// data directory before it is applied to an in-memory copy that serves reads; opening a Disk
+// replays the latest snapshot and the log after it to rebuild that copy, history included.
+type Disk struct {
+	*Memory
+	Config DiskConfig
+	// mu serialises the existence check, log append and in-memory apply of each mutation.
+	mu  sync.Mutex
+	log *wal.Log
+	// compactMu serialises compactions; compacted is the log offset of the last snapshot taken.
+	compactMu sync.Mutex
+	compacted uint64
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+func NewDisk(dir string, c DiskConfig) (*Disk, error) {
+	wl, err := wal.Open(dir, c.WAL)
+	if err != nil {
+		return nil, err
+	}
+	d := &Disk{Memory: NewMemory(), Config: c, log: wl, done: make(chan struct{})}
+	err = wl.Replay(func(record []byte) error {
+		mutations, err := decodeRecord(record)
+		if err != nil {
+			return err
+		}
+		for _, mutation := range mutations {
+			if err := d.Memory.apply(mutation.Op, mutation.Profile); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		wl.Close()
+		return nil, fmt.Errorf("replay %s: %w", dir, err)
+	}
+	// A record's revision is one more than its log offset, so revisions survive restarts and
+	// compaction.
+	d.Memory.revision = wl.NextOffset()
+	d.compacted = wl.NextOffset()
+	if c.CompactInterval > 0 {
+		d.wg.Add(1)
+		go d.compactLoop()
+	}
+	return d, nil
+}
+
+func (d *Disk) Create(profile *api.Profile) (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, err := d.Memory.Get(profile.GetId()); err == nil {
+		return 0, api.ErrProfileExists{Id: profile.GetId()}
+	}
+	return d.write(OpCreate, profile)
+}
+
+func (d *Disk) Update(profile *api.Profile) (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, err := d.Memory.Get(profile.GetId()); err != nil {
+		return 0, err
+	}
+	return d.write(OpUpdate, profile)
+}
+
+func (d *Disk) Delete(id string) (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, err := d.Memory.Get(id); err != nil {
+		return 0, err
+	}
+	return d.write(OpDelete, &api.Profile{Id: id})
+}
+
+// Apply logs the mutations as a single record, so that after a crash either all of them are
+// recovered or none are.
+func (d *Disk) Apply(mutations []Mutation) (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Memory.mu.RLock()
+	err := d.Memory.check(mutations)
+	d.Memory.mu.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+
+	revision := d.log.NextOffset() + 1
+	for _, mutation := range mutations {
+		mutation.Profile.Revision = revision
+	}
+	record, err := encodeBatchRecord(mutations)
+	if err != nil {
+		return 0, err
+	}
+	return d.append(record, mutations...)
+}
+
+// Compact snapshots the current profiles and drops the log segments the snapshot replaces.
+func (d *Disk) Compact() error {
+	d.compactMu.Lock()
+	defer d.compactMu.Unlock()
+
+	d.mu.Lock()
+	offset := d.log.NextOffset()
+	if offset == d.compacted {
+		d.mu.Unlock()
+		return nil
+	}
+	// Profile versions are snapshotted as the create and update records that made them, so replay
+	// treats snapshots and segments alike.
+	records, err := d.Memory.records()
+	d.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := d.log.Compact(offset, records); err != nil {
+		return err
+	}
+	d.compacted = offset
+	return nil
+}
+
+func (d *Disk) compactLoop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.Config.CompactInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.Compact(); err != nil {
+				log.Printf("compact profile store: %v", err)
+			}
+		case <-d.done:
+			return
+		}
+	}
+}
+
+func (d *Disk) Close() error {
+	close(d.done)
+	d.wg.Wait()
+	return d.log.Close()
+}
+
+// write logs the mutation and, once it is durable per the sync policy, applies it in memory.
+func (d *Disk) write(o Op, profile *api.Profile) (uint64, error) {
+	// d.mu is held, so nothing else can append before this record is given the next offset.
+	profile.Revision = d.log.NextOffset() + 1
+	record, err := encodeRecord(o, profile)
+	if err != nil {
+		return 0, err
+	}
+	return d.append(record, Mutation{Op: o, Profile: profile})
+}
+
+// append logs the record holding the mutations and then applies them in memory.
+func (d *Disk) append(record []byte, mutations ...Mutation) (uint64, error) {
+	offset, err := d.log.Append(record)
+	if err != nil {
+		return 0, err
+	}
+	revision := offset + 1
+	return revision, d.Memory.applyAt(revision, mutations...)
+}
+
+// Records are a single op byte followed by the gogo protobuf encoding of the profile. Batch records
+// are the opBatch byte followed by the records of each mutation, each prefixed by its uvarint
+// length.
+func encodeRecord(o Op, profile *api.Profile) ([]byte, error) {
+	record := make([]byte, 1+profile.Size())
+	record[0] = byte(o)
+	if _, err := profile.MarshalTo(record[1:]); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func encodeBatchRecord(mutations []Mutation) ([]byte, error) {
+	batch := []byte{byte(opBatch)}
+	for _, mutation := range mutations {
+		record, err := encodeRecord(mutation.Op, mutation.Profile)
+		if err != nil {
+			return nil, err
+		}
+		var n [binary.MaxVarintLen64]byte
+		batch = append(batch, n[:binary.PutUvarint(n[:], uint64(len(record)))]...)
+		batch = append(batch, record...)
+	}
+	return batch, nil
+}
+
+func decodeRecord(record []byte) ([]Mutation, error) {
+	if len(record) == 0 {
+		return nil, fmt.Errorf("empty record")
+	}
+	if Op(record[0]) != opBatch {
+		profile := &api.Profile{}
+		if err := profile.Unmarshal(record[1:]); err != nil {
+			return nil, err
+		}
+		return []Mutation{{Op: Op(record[0]), Profile: profile}}, nil
+	}
+
+	var mutations []Mutation
+	for rest := record[1:]; len(rest) > 0; {
+		size, n := binary.Uvarint(rest)
+		if n <= 0 || uint64(len(rest)-n) < size {
+			return nil, fmt.Errorf("malformed batch record")
+		}
+		batched, err := decodeRecord(rest[n : n+int(size)])
+		if err != nil {
+			return nil, err
+		}
+		mutations = append(mutations, batched...)
+		rest = rest[n+int(size):]
+	}
+	return mutations, nil
+}
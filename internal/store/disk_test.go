@@ -0,0 +1,90 @@
+package store
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/joshjon/go-profiles/internal/wal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskRecoversOnOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewDisk(dir, DiskConfig{})
+	require.NoError(t, err)
+	mustWrite(t)(s.Create(&api.Profile{Id: "1", FirstName: "Foo"}))
+	mustWrite(t)(s.Create(&api.Profile{Id: "2", FirstName: "Bar"}))
+	mustWrite(t)(s.Update(&api.Profile{Id: "1", FirstName: "Baz"}))
+	mustWrite(t)(s.Delete("2"))
+	revision := mustWrite(t)(s.Apply([]Mutation{
+		{Op: OpCreate, Profile: &api.Profile{Id: "3", FirstName: "Qux"}},
+		{Op: OpUpdate, Profile: &api.Profile{Id: "1", FirstName: "Baz"}},
+	}))
+	assert.Error(t, writeErr(s.Create(&api.Profile{Id: "1"})), "duplicate id")
+	assert.Error(t, writeErr(s.Apply([]Mutation{{Op: OpDelete, Profile: &api.Profile{Id: "2"}}})))
+	assert.Equal(t, api.ErrProfileNotFound{Id: "4"}, writeErr(s.Update(&api.Profile{Id: "4"})))
+	require.NoError(t, s.Close())
+
+	s, err = NewDisk(dir, DiskConfig{})
+	require.NoError(t, err)
+	defer s.Close()
+
+	profiles, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "1", profiles[0].Id)
+	assert.Equal(t, "Baz", profiles[0].FirstName)
+	assert.Equal(t, revision, profiles[0].Revision)
+	assert.Equal(t, "3", profiles[1].Id)
+	assert.Equal(t, revision, s.Revision())
+}
+
+func TestDiskCompact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := DiskConfig{WAL: wal.Config{MaxSegmentBytes: 64}}
+	s, err := NewDisk(dir, c)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		mustWrite(t)(s.Create(&api.Profile{Id: fmt.Sprint(i), FirstName: "Foo"}))
+	}
+	for i := 0; i < 5; i++ {
+		mustWrite(t)(s.Delete(fmt.Sprint(i)))
+	}
+	mustWrite(t)(s.Update(&api.Profile{Id: "9", FirstName: "Baz"}))
+	require.NoError(t, s.Compact())
+	revision := mustWrite(t)(s.Update(&api.Profile{Id: "9", FirstName: "Bar"}))
+	require.NoError(t, s.Close())
+
+	s, err = NewDisk(dir, c)
+	require.NoError(t, err)
+	defer s.Close()
+
+	count, err := s.Count()
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+	profile, err := s.Get("9")
+	require.NoError(t, err)
+	assert.Equal(t, "Bar", profile.FirstName)
+
+	// Compaction keeps every version, not just the latest.
+	versions, err := s.Versions("9")
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	assert.Equal(t, "Foo", versions[0].FirstName)
+	assert.Equal(t, "Baz", versions[1].FirstName)
+	assert.Equal(t, revision, versions[2].Revision)
+
+	// Revisions carry on from where they left off across restarts and compaction.
+	assert.Equal(t, revision, s.Revision())
+	assert.Equal(t, revision+1, mustWrite(t)(s.Delete("9")))
+}
@@ -0,0 +1,250 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+)
+
+// Op is a kind of mutation. Its value is also the first byte of the Disk store's log records.
+type Op byte
+
+const (
+	OpCreate Op = iota + 1
+	OpUpdate
+	OpDelete
+	// opBatch prefixes a Disk log record holding several mutations applied together.
+	opBatch
+)
+
+// A Mutation is one of the changes applied together by Apply. Deletes only need the profile's id.
+type Mutation struct {
+	Op      Op
+	Profile *api.Profile
+}
+
+// Memory is a ProfileStore that keeps profiles, and every prior version of them, in memory in
+// insertion order.
+type Memory struct {
+	mu sync.RWMutex
+	// versions holds each profile's versions, oldest first, so the last is the current profile.
+	versions map[string][]*api.Profile
+	order    []string
+	// revision is the revision of the latest mutation, incremented by each one.
+	revision uint64
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		versions: make(map[string][]*api.Profile),
+	}
+}
+
+func (m *Memory) Create(profile *api.Profile) (uint64, error) {
+	return m.mutate(OpCreate, profile)
+}
+
+func (m *Memory) Get(id string) (*api.Profile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	versions, ok := m.versions[id]
+	if !ok {
+		return nil, api.ErrProfileNotFound{Id: id}
+	}
+	return clone(versions[len(versions)-1]), nil
+}
+
+func (m *Memory) Update(profile *api.Profile) (uint64, error) {
+	return m.mutate(OpUpdate, profile)
+}
+
+func (m *Memory) Delete(id string) (uint64, error) {
+	return m.mutate(OpDelete, &api.Profile{Id: id})
+}
+
+func (m *Memory) List() ([]*api.Profile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	profiles := make([]*api.Profile, 0, len(m.order))
+	for _, id := range m.order {
+		versions := m.versions[id]
+		profiles = append(profiles, clone(versions[len(versions)-1]))
+	}
+	return profiles, nil
+}
+
+func (m *Memory) Versions(id string) ([]*api.Profile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	versions, ok := m.versions[id]
+	if !ok {
+		return nil, api.ErrProfileNotFound{Id: id}
+	}
+	clones := make([]*api.Profile, len(versions))
+	for i, version := range versions {
+		clones[i] = clone(version)
+	}
+	return clones, nil
+}
+
+func (m *Memory) Count() (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.versions), nil
+}
+
+func (m *Memory) Revision() uint64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.revision
+}
+
+// Apply performs the mutations atomically, so either all of them succeed or none do. They are all
+// assigned the same revision, so each profile may be mutated at most once.
+func (m *Memory) Apply(mutations []Mutation) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.check(mutations); err != nil {
+		return 0, err
+	}
+	m.revision++
+	for _, mutation := range mutations {
+		mutation.Profile.Revision = m.revision
+		if err := m.apply(mutation.Op, mutation.Profile); err != nil {
+			// check guarantees every mutation applies.
+			panic(err)
+		}
+	}
+	return m.revision, nil
+}
+
+// check returns the error the first of the mutations that can't be applied would fail with, if
+// any. Callers must hold mu.
+func (m *Memory) check(mutations []Mutation) error {
+	seen := make(map[string]bool, len(mutations))
+	for _, mutation := range mutations {
+		id := mutation.Profile.GetId()
+		if seen[id] {
+			return fmt.Errorf("profile %s is mutated more than once", id)
+		}
+		seen[id] = true
+
+		_, exists := m.versions[id]
+		switch mutation.Op {
+		case OpCreate:
+			if exists {
+				return api.ErrProfileExists{Id: id}
+			}
+		case OpUpdate, OpDelete:
+			if !exists {
+				return api.ErrProfileNotFound{Id: id}
+			}
+		default:
+			return fmt.Errorf("unknown op %d", mutation.Op)
+		}
+	}
+	return nil
+}
+
+func (m *Memory) mutate(o Op, profile *api.Profile) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	profile.Revision = m.revision + 1
+	if err := m.apply(o, profile); err != nil {
+		return 0, err
+	}
+	m.revision++
+	return m.revision, nil
+}
+
+// records returns the mutations that rebuild the current state, including every version of every
+// profile, as encoded log records.
+func (m *Memory) records() ([][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var records [][]byte
+	for _, id := range m.order {
+		for i, version := range m.versions[id] {
+			o := OpUpdate
+			if i == 0 {
+				o = OpCreate
+			}
+			record, err := encodeRecord(o, version)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// applyAt performs mutations that were assigned their revision elsewhere.
+func (m *Memory) applyAt(revision uint64, mutations ...Mutation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, mutation := range mutations {
+		if err := m.apply(mutation.Op, mutation.Profile); err != nil {
+			return err
+		}
+	}
+	m.revision = revision
+	return nil
+}
+
+// apply performs the mutation without assigning it a revision. Callers must hold mu.
+func (m *Memory) apply(o Op, profile *api.Profile) error {
+	id := profile.GetId()
+	_, exists := m.versions[id]
+
+	switch o {
+	case OpCreate:
+		if exists {
+			return api.ErrProfileExists{Id: id}
+		}
+		m.versions[id] = []*api.Profile{clone(profile)}
+		m.order = append(m.order, id)
+	case OpUpdate:
+		if !exists {
+			return api.ErrProfileNotFound{Id: id}
+		}
+		m.versions[id] = append(m.versions[id], clone(profile))
+	case OpDelete:
+		// Deletes are permanent, so the history goes along with the profile.
+		if !exists {
+			return api.ErrProfileNotFound{Id: id}
+		}
+		delete(m.versions, id)
+		for i, orderedID := range m.order {
+			if orderedID == id {
+				m.order = append(m.order[:i], m.order[i+1:]...)
+				break
+			}
+		}
+	default:
+		return fmt.Errorf("unknown op %d", o)
+	}
+	return nil
+}
+
+// Profiles are copied on the way in and out so callers can't mutate stored state. The copy is made
+// by a marshal round trip because proto.Clone can't merge the stdtime fields' time.Location.
+func clone(profile *api.Profile) *api.Profile {
+	b, err := profile.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	c := &api.Profile{}
+	if err := c.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return c
+}
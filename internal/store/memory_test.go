@@ -0,0 +1,131 @@
+package store
+
+import (
+	"testing"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCreateGet(t *testing.T) {
+	s := NewMemory()
+	profile := &api.Profile{Id: "1", FirstName: "Foo", LastName: "Bar"}
+	mustWrite(t)(s.Create(profile))
+	assert.Equal(t, api.ErrProfileExists{Id: "1"}, writeErr(s.Create(profile)))
+
+	got, err := s.Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "Foo", got.FirstName)
+
+	// Mutating the returned profile must not change the stored one.
+	got.FirstName = "Baz"
+	got, err = s.Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "Foo", got.FirstName)
+
+	_, err = s.Get("2")
+	assert.Equal(t, api.ErrProfileNotFound{Id: "2"}, err)
+}
+
+func TestMemoryUpdateDelete(t *testing.T) {
+	s := NewMemory()
+	mustWrite(t)(s.Create(&api.Profile{Id: "1", FirstName: "Foo"}))
+	mustWrite(t)(s.Create(&api.Profile{Id: "2", FirstName: "Bar"}))
+
+	mustWrite(t)(s.Update(&api.Profile{Id: "1", FirstName: "Baz"}))
+	assert.Equal(t, api.ErrProfileNotFound{Id: "3"}, writeErr(s.Update(&api.Profile{Id: "3"})))
+
+	mustWrite(t)(s.Delete("1"))
+	assert.Equal(t, api.ErrProfileNotFound{Id: "1"}, writeErr(s.Delete("1")))
+
+	profiles, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, profiles, 1)
+	assert.Equal(t, "2", profiles[0].Id)
+
+	count, err := s.Count()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestMemoryVersions(t *testing.T) {
+	s := NewMemory()
+	mustWrite(t)(s.Create(&api.Profile{Id: "1", FirstName: "Foo"}))
+	mustWrite(t)(s.Update(&api.Profile{Id: "1", FirstName: "Bar"}))
+	mustWrite(t)(s.Update(&api.Profile{Id: "1", FirstName: "Baz"}))
+
+	versions, err := s.Versions("1")
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	for i, firstName := range []string{"Foo", "Bar", "Baz"} {
+		assert.Equal(t, firstName, versions[i].FirstName)
+		assert.Equal(t, uint64(i+1), versions[i].Revision)
+	}
+
+	// Deleting a profile removes its history.
+	mustWrite(t)(s.Delete("1"))
+	_, err = s.Versions("1")
+	assert.Equal(t, api.ErrProfileNotFound{Id: "1"}, err)
+}
+
+func TestMemoryApply(t *testing.T) {
+	s := NewMemory()
+	mustWrite(t)(s.Create(&api.Profile{Id: "1", FirstName: "Foo"}))
+
+	// A failing mutation prevents the others from being applied.
+	err := writeErr(s.Apply([]Mutation{
+		{Op: OpCreate, Profile: &api.Profile{Id: "2"}},
+		{Op: OpUpdate, Profile: &api.Profile{Id: "3"}},
+	}))
+	assert.Equal(t, api.ErrProfileNotFound{Id: "3"}, err)
+	assert.Error(t, writeErr(s.Apply([]Mutation{
+		{Op: OpUpdate, Profile: &api.Profile{Id: "1"}},
+		{Op: OpDelete, Profile: &api.Profile{Id: "1"}},
+	})), "same profile twice")
+	count, err := s.Count()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, uint64(1), s.Revision())
+
+	revision := mustWrite(t)(s.Apply([]Mutation{
+		{Op: OpCreate, Profile: &api.Profile{Id: "2"}},
+		{Op: OpUpdate, Profile: &api.Profile{Id: "1", FirstName: "Bar"}},
+	}))
+	assert.Equal(t, uint64(2), revision)
+	profiles, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "Bar", profiles[0].FirstName)
+	for _, profile := range profiles {
+		assert.Equal(t, revision, profile.Revision)
+	}
+}
+
+func TestMemoryRevision(t *testing.T) {
+	s := NewMemory()
+	assert.Equal(t, uint64(0), s.Revision())
+	assert.Equal(t, uint64(1), mustWrite(t)(s.Create(&api.Profile{Id: "1"})))
+	assert.Equal(t, uint64(2), mustWrite(t)(s.Update(&api.Profile{Id: "1"})))
+	profile, err := s.Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), profile.Revision)
+
+	// Failed mutations don't use up a revision.
+	assert.Error(t, writeErr(s.Update(&api.Profile{Id: "2"})))
+	assert.Equal(t, uint64(3), mustWrite(t)(s.Delete("1")))
+	assert.Equal(t, uint64(3), s.Revision())
+}
+
+// mustWrite fails the test if a store mutation fails, otherwise returning its revision.
+func mustWrite(t *testing.T) func(revision uint64, err error) uint64 {
+	return func(revision uint64, err error) uint64 {
+		t.Helper()
+		require.NoError(t, err)
+		return revision
+	}
+}
+
+func writeErr(_ uint64, err error) error {
+	return err
+}
@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// Limits on custom attributes, which go-proto-validators can't check as they're a map.
+const (
+	maxAttributes = 50
+	// maxAttributeDepth is how deeply lists and structs may be nested in an attribute's value.
+	maxAttributeDepth = 5
+	// maxAttributeBytes is the largest an attribute's value may be, encoded.
+	maxAttributeBytes = 4096
+)
+
+var attributeNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{0,63}$`)
+
+var attributesType = reflect.TypeOf(map[string]*types.Value(nil))
+
+// checkAttributes checks custom attributes, such as those of ProfileDto, reporting violations for
+// each attribute by its name under path, e.g. custom_attributes.department.
+func checkAttributes(attributes map[string]*types.Value, path string) Violations {
+	if len(attributes) > maxAttributes {
+		return Violations{{Field: path, Description: fmt.Sprintf("must have at most %d entries", maxAttributes)}}
+	}
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var violations Violations
+	for _, name := range names {
+		field := path + "." + name
+		if !attributeNamePattern.MatchString(name) {
+			violations = append(violations, Violation{Field: field,
+				Description: "must be named with at most 64 letters, digits, '_' or '-', starting with a letter"})
+			continue
+		}
+		value := attributes[name]
+		if description := checkValue(value, 0); description != "" {
+			violations = append(violations, Violation{Field: field, Description: description})
+			continue
+		}
+		if value.Size() > maxAttributeBytes {
+			violations = append(violations, Violation{Field: field,
+				Description: fmt.Sprintf("must be at most %d bytes", maxAttributeBytes)})
+		}
+	}
+	return violations
+}
+
+// checkValue describes why a value nested depth lists or structs deep is invalid, or returns "" if
+// it's valid.
+func checkValue(value *types.Value, depth int) string {
+	if depth > maxAttributeDepth {
+		return fmt.Sprintf("must be nested at most %d deep", maxAttributeDepth)
+	}
+	switch kind := value.GetKind().(type) {
+	case nil:
+		return "must have a value"
+	case *types.Value_ListValue:
+		for _, v := range kind.ListValue.GetValues() {
+			if description := checkValue(v, depth+1); description != "" {
+				return description
+			}
+		}
+	case *types.Value_StructValue:
+		for _, v := range kind.StructValue.GetFields() {
+			if description := checkValue(v, depth+1); description != "" {
+				return description
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckAttributes(t *testing.T) {
+	str := func(s string) *types.Value { return &types.Value{Kind: &types.Value_StringValue{StringValue: s}} }
+	list := func(v *types.Value) *types.Value {
+		return &types.Value{Kind: &types.Value_ListValue{ListValue: &types.ListValue{Values: []*types.Value{v}}}}
+	}
+	nested := str("deep")
+	for i := 0; i <= maxAttributeDepth; i++ {
+		nested = list(nested)
+	}
+	profile := func(attributes map[string]*types.Value) *api.ProfileDto {
+		return &api.ProfileDto{FirstName: "Foo", LastName: "Bar", CustomAttributes: attributes}
+	}
+
+	assert.Empty(t, CheckFields(profile(map[string]*types.Value{
+		"department": str("Eng"),
+		"teams":      list(str("Core")),
+		"manager": {Kind: &types.Value_StructValue{StructValue: &types.Struct{
+			Fields: map[string]*types.Value{"name": str("Jo")},
+		}}},
+	})))
+
+	violations := CheckFields(profile(map[string]*types.Value{
+		"":         str("empty"),
+		"1st":      str("digit"),
+		"bad key":  str("space"),
+		"kindless": {},
+		"nested":   list(&types.Value{}),
+		"deep":     nested,
+		"large":    str(strings.Repeat("a", maxAttributeBytes)),
+		"valid":    str("ok"),
+	}))
+	var fields []string
+	for _, violation := range violations {
+		assert.NotEmpty(t, violation.Description)
+		fields = append(fields, violation.Field)
+	}
+	assert.Equal(t, []string{
+		"custom_attributes.",
+		"custom_attributes.1st",
+		"custom_attributes.bad key",
+		"custom_attributes.deep",
+		"custom_attributes.kindless",
+		"custom_attributes.large",
+		"custom_attributes.nested",
+	}, fields)
+
+	many := make(map[string]*types.Value)
+	for i := 0; i <= maxAttributes; i++ {
+		many[fmt.Sprintf("attribute%d", i)] = str("")
+	}
+	assert.Equal(t, Violations{{Field: "custom_attributes", Description: "must have at most 50 entries"}}, CheckFields(profile(many)))
+
+	// Attributes of nested profiles are named by their path from the request.
+	req := &api.UpdateProfileReq{Id: "1", Profile: profile(map[string]*types.Value{"kindless": {}})}
+	assert.Equal(t, Violations{{Field: "profile.custom_attributes.kindless", Description: "must have a value"}}, CheckFields(req))
+}
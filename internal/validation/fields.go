@@ -0,0 +1,183 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/types"
+	validator "github.com/mwitkow/go-proto-validators"
+)
+
+// Message is a message with go-proto-validators rules.
+type Message interface {
+	descriptor.Message
+	Validate() error
+}
+
+// CheckFields checks the message against the go-proto-validators rules in its proto definition,
+// like its generated Validate method, except that rather than stopping at the first field that
+// breaks a rule it returns a violation for each one. Fields are named by their path from the
+// message, e.g. emails[0].address.
+func CheckFields(msg Message) Violations {
+	violations := checkMessage(reflect.ValueOf(msg), "")
+	// Validate is the source of truth, so report its error in case it checks a rule that isn't
+	// checked here.
+	if len(violations) == 0 {
+		if err := msg.Validate(); err != nil {
+			violations = Violations{{Field: "", Description: err.Error()}}
+		}
+	}
+	return violations
+}
+
+func checkMessage(msg reflect.Value, prefix string) Violations {
+	m, ok := msg.Interface().(Message)
+	if !ok || msg.IsNil() {
+		return nil
+	}
+	var violations Violations
+	for _, field := range messageFields(m) {
+		value := msg.Elem().Field(field.index)
+		violations = append(violations, checkField(value, field, prefix+field.name)...)
+	}
+	return violations
+}
+
+func checkField(value reflect.Value, field *fieldRules, path string) Violations {
+	rules := field.rules
+	violation := func(description string) Violations {
+		if rules.GetHumanError() != "" {
+			description = rules.GetHumanError()
+		}
+		return Violations{{Field: path, Description: description}}
+	}
+
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type() == attributesType {
+			return checkAttributes(value.Interface().(map[string]*types.Value), path)
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		if rules.RepeatedCountMin != nil && int64(value.Len()) < rules.GetRepeatedCountMin() {
+			return violation(fmt.Sprintf("must have at least %d elements", rules.GetRepeatedCountMin()))
+		}
+		if rules.RepeatedCountMax != nil && int64(value.Len()) > rules.GetRepeatedCountMax() {
+			return violation(fmt.Sprintf("must have at most %d elements", rules.GetRepeatedCountMax()))
+		}
+		var violations Violations
+		for i := 0; i < value.Len(); i++ {
+			violations = append(violations, checkField(value.Index(i), field, path+"["+strconv.Itoa(i)+"]")...)
+		}
+		return violations
+	case reflect.Ptr:
+		if value.IsNil() {
+			if rules.GetMsgExists() {
+				return violation("must be set")
+			}
+			return nil
+		}
+		return checkMessage(value, path+".")
+	case reflect.String:
+		s := value.String()
+		if rules.GetStringNotEmpty() && s == "" {
+			return violation("must not be empty")
+		}
+		if rules.LengthGt != nil && int64(len(s)) <= rules.GetLengthGt() {
+			return violation(fmt.Sprintf("must be longer than %d characters", rules.GetLengthGt()))
+		}
+		if rules.LengthLt != nil && int64(len(s)) >= rules.GetLengthLt() {
+			return violation(fmt.Sprintf("must be shorter than %d characters", rules.GetLengthLt()))
+		}
+		if rules.LengthEq != nil && int64(len(s)) != rules.GetLengthEq() {
+			return violation(fmt.Sprintf("must be %d characters", rules.GetLengthEq()))
+		}
+		if field.regex != nil && !field.regex.MatchString(s) {
+			return violation(fmt.Sprintf("must match %s", rules.GetRegex()))
+		}
+	case reflect.Int32, reflect.Int64:
+		n := value.Int()
+		if rules.IntGt != nil && n <= rules.GetIntGt() {
+			return violation(fmt.Sprintf("must be greater than %d", rules.GetIntGt()))
+		}
+		if rules.IntLt != nil && n >= rules.GetIntLt() {
+			return violation(fmt.Sprintf("must be less than %d", rules.GetIntLt()))
+		}
+	case reflect.Uint32, reflect.Uint64:
+		n := value.Uint()
+		if rules.IntGt != nil && rules.GetIntGt() >= 0 && n <= uint64(rules.GetIntGt()) {
+			return violation(fmt.Sprintf("must be greater than %d", rules.GetIntGt()))
+		}
+		if rules.IntLt != nil && (rules.GetIntLt() <= 0 || n >= uint64(rules.GetIntLt())) {
+			return violation(fmt.Sprintf("must be less than %d", rules.GetIntLt()))
+		}
+	}
+	return nil
+}
+
+// fieldRules are the rules for a field of a message type, along with where to find it in the
+// message's struct.
+type fieldRules struct {
+	name  string
+	index int
+	rules *validator.FieldValidator
+	regex *regexp.Regexp
+}
+
+// Field rules are read from the message descriptors once per message type.
+var fieldCache sync.Map
+
+func messageFields(msg Message) []*fieldRules {
+	t := reflect.TypeOf(msg)
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.([]*fieldRules)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	byNumber := make(map[int32]*descriptor.FieldDescriptorProto, len(md.GetField()))
+	for _, field := range md.GetField() {
+		byNumber[field.GetNumber()] = field
+	}
+
+	var fields []*fieldRules
+	for i := 0; i < t.Elem().NumField(); i++ {
+		number, ok := fieldNumber(t.Elem().Field(i).Tag.Get("protobuf"))
+		if !ok {
+			continue
+		}
+		fd := byNumber[number]
+		if fd == nil {
+			continue
+		}
+		rules := &validator.FieldValidator{}
+		if ext, err := proto.GetExtension(fd.GetOptions(), validator.E_Field); err == nil {
+			rules = ext.(*validator.FieldValidator)
+		}
+		field := &fieldRules{name: fd.GetName(), index: i, rules: rules}
+		if rules.Regex != nil {
+			field.regex = regexp.MustCompile(rules.GetRegex())
+		}
+		fields = append(fields, field)
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// fieldNumber parses the field number out of a protobuf struct tag, such as
+// "bytes,1,opt,name=first_name,proto3".
+func fieldNumber(tag string) (int32, bool) {
+	parts := strings.Split(tag, ",")
+	if len(parts) < 2 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(parts[1], 10, 32)
+	return int32(n), err == nil
+}
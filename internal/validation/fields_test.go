@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckFields(t *testing.T) {
+	valid := &api.ProfileDto{
+		FirstName: "Foo",
+		LastName:  "Bar",
+		Emails:    []*api.EmailAddress{{Address: "foo@example.com"}},
+	}
+	assert.Empty(t, CheckFields(valid))
+
+	invalid := &api.ProfileDto{
+		DisplayName:  strings.Repeat("a", 256),
+		Emails:       []*api.EmailAddress{{Address: "foo@example.com"}, {Address: "foo"}},
+		PhoneNumbers: []*api.PhoneNumber{{Number: "0400 000 000"}},
+		Locale:       "en_AU",
+	}
+	var fields []string
+	for _, violation := range CheckFields(invalid) {
+		assert.NotEmpty(t, violation.Description)
+		fields = append(fields, violation.Field)
+	}
+	assert.Equal(t, []string{
+		"first_name",
+		"last_name",
+		"display_name",
+		"emails[1].address",
+		"phone_numbers[0].number",
+		"locale",
+	}, fields)
+	assert.Error(t, invalid.Validate())
+
+	// Nested requests are named by their path from the request.
+	req := &api.UpdateProfileReq{Id: "1", Profile: &api.ProfileDto{FirstName: "Foo"}}
+	assert.Equal(t, Violations{{Field: "profile.last_name", Description: "must not be empty"}}, CheckFields(req))
+}
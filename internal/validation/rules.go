@@ -0,0 +1,290 @@
+// Package validation checks profiles against rules configured at runtime, on top of the rules
+// generated from the proto definitions.
+package validation
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Knetic/govaluate"
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"gopkg.in/yaml.v2"
+)
+
+// Rules are the validation rules for ProfileDto, loaded from YAML such as:
+//
+//	fields:
+//	  first_name:
+//	    max_length: 50
+//	    charset: [letter, mark, space]
+//	    allow: "'-"
+//	attributes:
+//	  department:
+//	    required: true
+//	    pattern: "^[A-Z][a-z]+$"
+//	cross_field:
+//	  - expression: "display_name == '' || email_count > 0"
+//	    message: "profiles with a display name need an email address"
+type Rules struct {
+	// Fields holds rules for the string fields of ProfileDto, keyed by field name.
+	Fields map[string]*StringRule `yaml:"fields"`
+	// Attributes holds rules for custom attributes, keyed by attribute name. Rules other than
+	// required only apply to string values.
+	Attributes map[string]*AttributeRule `yaml:"attributes"`
+	// CrossField holds rules relating several fields.
+	CrossField []*CrossFieldRule `yaml:"cross_field"`
+}
+
+// StringRule constrains a string. Lengths are in characters and unset constraints aren't checked.
+// Empty strings are only checked by min_length, so optional fields can still be left out.
+type StringRule struct {
+	MinLength int    `yaml:"min_length"`
+	MaxLength int    `yaml:"max_length"`
+	Pattern   string `yaml:"pattern"`
+	// Charset lists the character classes allowed: letter, digit, space, punct, symbol and mark
+	// (combining marks such as accents).
+	Charset []string `yaml:"charset"`
+	// Allow lists characters allowed in addition to those in Charset.
+	Allow string `yaml:"allow"`
+
+	pattern *regexp.Regexp
+}
+
+type AttributeRule struct {
+	Required   bool `yaml:"required"`
+	StringRule `yaml:",inline"`
+}
+
+// CrossFieldRule is a boolean govaluate expression every profile must satisfy. The parameters
+// available are the string fields of ProfileDto along with email_count, phone_number_count and
+// postal_address_count.
+type CrossFieldRule struct {
+	Expression string `yaml:"expression"`
+	// Message describes the rule to clients whose profiles break it.
+	Message string `yaml:"message"`
+	// Field is the field reported as breaking the rule. Defaults to profile.
+	Field string `yaml:"field"`
+
+	expression *govaluate.EvaluableExpression
+}
+
+// Violation describes why a field broke a rule.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Violations is the error returned for a profile that breaks rules, with a violation for each.
+type Violations []Violation
+
+func (v Violations) Error() string {
+	descriptions := make([]string, len(v))
+	for i, violation := range v {
+		descriptions[i] = fmt.Sprintf("invalid field %s: %s", violation.Field, violation.Description)
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// The string fields of ProfileDto that rules can be given for. Note id is only set when creating a
+// profile with a client-chosen id.
+var stringFields = map[string]func(dto *api.ProfileDto) string{
+	"id":            (*api.ProfileDto).GetId,
+	"first_name":    (*api.ProfileDto).GetFirstName,
+	"last_name":     (*api.ProfileDto).GetLastName,
+	"display_name":  (*api.ProfileDto).GetDisplayName,
+	"date_of_birth": (*api.ProfileDto).GetDateOfBirth,
+	"locale":        (*api.ProfileDto).GetLocale,
+}
+
+// populatedProfile sets every parameter of cross-field rules, so that type checking them doesn't
+// skip operands that empty fields would short-circuit.
+var populatedProfile = &api.ProfileDto{
+	Id:              "id",
+	FirstName:       "first",
+	LastName:        "last",
+	DisplayName:     "display",
+	DateOfBirth:     "2000-01-01",
+	Locale:          "en-AU",
+	Emails:          []*api.EmailAddress{{}},
+	PhoneNumbers:    []*api.PhoneNumber{{}},
+	PostalAddresses: []*api.PostalAddress{{}},
+}
+
+var charsets = map[string]func(r rune) bool{
+	"letter": unicode.IsLetter,
+	"digit":  unicode.IsDigit,
+	"space":  unicode.IsSpace,
+	"punct":  unicode.IsPunct,
+	"symbol": unicode.IsSymbol,
+	"mark":   unicode.IsMark,
+}
+
+// Load reads rules from a YAML file.
+func Load(path string) (*Rules, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse parses YAML rules, failing if any of them are invalid.
+func Parse(b []byte) (*Rules, error) {
+	rules := &Rules{}
+	if err := yaml.UnmarshalStrict(b, rules); err != nil {
+		return nil, err
+	}
+	for field, rule := range rules.Fields {
+		if _, ok := stringFields[field]; !ok {
+			return nil, fmt.Errorf("rules for unknown field %s", field)
+		}
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field, err)
+		}
+	}
+	for name, rule := range rules.Attributes {
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+	}
+	for _, rule := range rules.CrossField {
+		expression, err := govaluate.NewEvaluableExpression(rule.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("cross field rule %q: %w", rule.Expression, err)
+		}
+		rule.expression = expression
+		// Catch expressions using unknown parameters, or comparing them to values of another
+		// type, now rather than when validating.
+		if _, err := rule.check(populatedProfile); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+func (r *StringRule) compile() error {
+	for _, class := range r.Charset {
+		if _, ok := charsets[class]; !ok {
+			return fmt.Errorf("unknown character class %s", class)
+		}
+	}
+	if r.Pattern == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return err
+	}
+	r.pattern = pattern
+	return nil
+}
+
+// Validate checks the DTO against every rule, returning Violations for those it breaks.
+func (r *Rules) Validate(dto *api.ProfileDto) error {
+	var violations Violations
+	for field, rule := range r.Fields {
+		if description := rule.check(stringFields[field](dto)); description != "" {
+			violations = append(violations, Violation{Field: field, Description: description})
+		}
+	}
+	for name, rule := range r.Attributes {
+		field := "custom_attributes." + name
+		value, ok := dto.GetCustomAttributes()[name]
+		if !ok {
+			if rule.Required {
+				violations = append(violations, Violation{Field: field, Description: "is required"})
+			}
+			continue
+		}
+		if _, ok := value.GetKind().(*types.Value_StringValue); !ok {
+			continue
+		}
+		if description := rule.check(value.GetStringValue()); description != "" {
+			violations = append(violations, Violation{Field: field, Description: description})
+		}
+	}
+	for _, rule := range r.CrossField {
+		violation, err := rule.check(dto)
+		if err != nil {
+			return err
+		}
+		if violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Field < violations[j].Field })
+	return violations
+}
+
+// check returns a description of the rule s breaks, if any.
+func (r *StringRule) check(s string) string {
+	length := utf8.RuneCountInString(s)
+	if length < r.MinLength {
+		return fmt.Sprintf("must be at least %d characters", r.MinLength)
+	}
+	if s == "" {
+		return ""
+	}
+	if r.MaxLength > 0 && length > r.MaxLength {
+		return fmt.Sprintf("must be at most %d characters", r.MaxLength)
+	}
+	if r.pattern != nil && !r.pattern.MatchString(s) {
+		return fmt.Sprintf("must match %s", r.Pattern)
+	}
+	if len(r.Charset) > 0 {
+		for _, c := range s {
+			if !r.allows(c) {
+				return fmt.Sprintf("must not contain %q", c)
+			}
+		}
+	}
+	return ""
+}
+
+func (r *StringRule) allows(c rune) bool {
+	if strings.ContainsRune(r.Allow, c) {
+		return true
+	}
+	for _, class := range r.Charset {
+		if charsets[class](c) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CrossFieldRule) check(dto *api.ProfileDto) (*Violation, error) {
+	params := map[string]interface{}{
+		"email_count":          float64(len(dto.GetEmails())),
+		"phone_number_count":   float64(len(dto.GetPhoneNumbers())),
+		"postal_address_count": float64(len(dto.GetPostalAddresses())),
+	}
+	for field, get := range stringFields {
+		params[field] = get(dto)
+	}
+	result, err := r.expression.Evaluate(params)
+	if err != nil {
+		return nil, fmt.Errorf("cross field rule %q: %w", r.Expression, err)
+	}
+	if ok, _ := result.(bool); ok {
+		return nil, nil
+	}
+	message := r.Message
+	if message == "" {
+		message = "must satisfy " + r.Expression
+	}
+	field := r.Field
+	if field == "" {
+		field = "profile"
+	}
+	return &Violation{Field: field, Description: message}, nil
+}
@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/joshjon/go-profiles/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRules = `
+fields:
+  first_name:
+    min_length: 2
+    max_length: 5
+    charset: [letter, mark]
+    allow: "-"
+  locale:
+    pattern: "^en"
+attributes:
+  department:
+    required: true
+    max_length: 3
+cross_field:
+  - expression: "display_name == '' || email_count > 0"
+    field: emails
+    message: "are required with a display name"
+`
+
+func TestValidate(t *testing.T) {
+	rules, err := Parse([]byte(testRules))
+	require.NoError(t, err)
+
+	department := func(name string) map[string]*types.Value {
+		return map[string]*types.Value{"department": {Kind: &types.Value_StringValue{StringValue: name}}}
+	}
+	require.NoError(t, rules.Validate(&api.ProfileDto{FirstName: "Zoë", CustomAttributes: department("Eng")}))
+	require.NoError(t, rules.Validate(&api.ProfileDto{
+		FirstName:        "Jo-Jo",
+		Locale:           "en-AU",
+		DisplayName:      "Jo",
+		Emails:           []*api.EmailAddress{{Address: "jo@example.com"}},
+		CustomAttributes: department("Eng"),
+	}))
+
+	err = rules.Validate(&api.ProfileDto{FirstName: "J0", Locale: "fr", DisplayName: "Jo"})
+	assert.Equal(t, Violations{
+		{Field: "custom_attributes.department", Description: "is required"},
+		{Field: "emails", Description: "are required with a display name"},
+		{Field: "first_name", Description: `must not contain '0'`},
+		{Field: "locale", Description: "must match ^en"},
+	}, err)
+
+	err = rules.Validate(&api.ProfileDto{FirstName: "Josephine", CustomAttributes: department("Engineering")})
+	assert.Equal(t, Violations{
+		{Field: "custom_attributes.department", Description: "must be at most 3 characters"},
+		{Field: "first_name", Description: "must be at most 5 characters"},
+	}, err)
+}
+
+func TestParseInvalid(t *testing.T) {
+	for scenario, rules := range map[string]string{
+		"unknown field":     "fields: {middle_name: {max_length: 1}}",
+		"unknown rule":      "fields: {first_name: {max_len: 1}}",
+		"unknown charset":   "fields: {first_name: {charset: [emoji]}}",
+		"bad pattern":       "fields: {first_name: {pattern: '('}}",
+		"bad expression":    "cross_field: [{expression: 'first_name =='}]",
+		"unknown parameter": "cross_field: [{expression: 'middle_name == first_name'}]",
+		"mismatched types":  "cross_field: [{expression: \"date_of_birth == '' || date_of_birth >= '1900-01-01'\"}]",
+	} {
+		_, err := Parse([]byte(rules))
+		assert.Error(t, err, scenario)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "validation-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rules.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testRules), 0600))
+	rules, err := Load(path)
+	require.NoError(t, err)
+	assert.Len(t, rules.Fields, 2)
+	require.NoError(t, rules.Validate(&api.ProfileDto{
+		FirstName:        "Jo",
+		DateOfBirth:      "1990-05-17",
+		CustomAttributes: map[string]*types.Value{"department": {Kind: &types.Value_StringValue{StringValue: "Eng"}}},
+	}))
+
+	// The example rules shipped with the agent must load and evaluate.
+	rules, err = Load("../../config/validation.yaml")
+	require.NoError(t, err)
+	require.NoError(t, rules.Validate(&api.ProfileDto{FirstName: "Jo", DateOfBirth: "1990-05-17"}))
+	err = rules.Validate(&api.ProfileDto{FirstName: "Jo", DateOfBirth: "1899-12-31"})
+	assert.Equal(t, Violations{{Field: "date_of_birth", Description: "must be no earlier than 1900"}}, err)
+}
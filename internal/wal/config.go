@@ -0,0 +1,54 @@
+package wal
+
+import (
+	"fmt"
+	"time"
+)
+
+// SyncPolicy controls when appended records are fsynced to stable storage.
+type SyncPolicy int
+
+const (
+	// SyncAlways fsyncs after every append. Slowest, but an acknowledged write is never lost.
+	SyncAlways SyncPolicy = iota
+	// SyncInterval fsyncs in the background every Config.SyncInterval.
+	SyncInterval
+	// SyncNever leaves flushing to the operating system.
+	SyncNever
+)
+
+const (
+	defaultSyncInterval    = time.Second
+	defaultMaxSegmentBytes = 16 << 20
+)
+
+type Config struct {
+	Sync         SyncPolicy
+	SyncInterval time.Duration
+	// MaxSegmentBytes is the size at which the active segment is sealed and a new one started.
+	MaxSegmentBytes int64
+}
+
+func ParseSyncPolicy(policy string) (SyncPolicy, error) {
+	switch policy {
+	case "always":
+		return SyncAlways, nil
+	case "interval":
+		return SyncInterval, nil
+	case "never":
+		return SyncNever, nil
+	}
+	return 0, fmt.Errorf("unknown fsync policy %q, expected always, interval or never", policy)
+}
+
+func (p SyncPolicy) String() string {
+	switch p {
+	case SyncAlways:
+		return "always"
+	case SyncInterval:
+		return "interval"
+	case SyncNever:
+		return "never"
+	}
+	return fmt.Sprintf("SyncPolicy(%d)", int(p))
+}
@@ -0,0 +1,73 @@
+package wal
+
+import (
+	"io"
+	"os"
+)
+
+// entWidth is the size of an index entry: the position of a record within its segment's log file.
+// Entries are fixed width so the entry for a record is found at (offset - baseOffset) * entWidth.
+const entWidth = 8
+
+type index struct {
+	file *os.File
+	size int64
+}
+
+func newIndex(f *os.File) (*index, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	// Drop a partially written trailing entry.
+	size := info.Size() - info.Size()%entWidth
+	if size != info.Size() {
+		if err := f.Truncate(size); err != nil {
+			return nil, err
+		}
+	}
+	return &index{file: f, size: size}, nil
+}
+
+func (i *index) entries() uint64 {
+	return uint64(i.size / entWidth)
+}
+
+// read returns the log file position of the nth record in the segment.
+func (i *index) read(n uint64) (int64, error) {
+	if n >= i.entries() {
+		return 0, io.EOF
+	}
+	b := make([]byte, entWidth)
+	if _, err := i.file.ReadAt(b, int64(n)*entWidth); err != nil {
+		return 0, err
+	}
+	return int64(enc.Uint64(b)), nil
+}
+
+func (i *index) write(pos int64) error {
+	b := make([]byte, entWidth)
+	enc.PutUint64(b, uint64(pos))
+	if _, err := i.file.WriteAt(b, i.size); err != nil {
+		return err
+	}
+	i.size += entWidth
+	return nil
+}
+
+// truncate drops every entry from the nth onwards.
+func (i *index) truncate(n uint64) error {
+	if err := i.file.Truncate(int64(n) * entWidth); err != nil {
+		return err
+	}
+	i.size = int64(n) * entWidth
+	return nil
+}
+
+func (i *index) sync() error {
+	return i.file.Sync()
+}
+
+func (i *index) close() error {
+	return i.file.Close()
+}
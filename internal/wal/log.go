@@ -0,0 +1,294 @@
+package wal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Log is an append-only sequence of records split across size-bounded segments in a directory.
+// Every record is assigned the next offset in the log when it is appended.
+//
+// The log can be compacted: the caller supplies a snapshot of its state as of some offset, which
+// is written alongside the segments, and every segment wholly below that offset is deleted.
+// Replay then starts from the latest snapshot instead of the first record ever written.
+type Log struct {
+	Dir    string
+	Config Config
+
+	mu       sync.RWMutex
+	segments []*segment
+	active   *segment
+	// snapshot is the offset of the latest snapshot, or zero when there is none.
+	snapshot uint64
+	dirty    bool
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func Open(dir string, c Config) (*Log, error) {
+	if c.SyncInterval == 0 {
+		c.SyncInterval = defaultSyncInterval
+	}
+	if c.MaxSegmentBytes == 0 {
+		c.MaxSegmentBytes = defaultMaxSegmentBytes
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	l := &Log{Dir: dir, Config: c, done: make(chan struct{})}
+	if err := l.setup(); err != nil {
+		l.closeSegments()
+		return nil, err
+	}
+	if c.Sync == SyncInterval {
+		l.wg.Add(1)
+		go l.syncLoop()
+	}
+	return l, nil
+}
+
+func (l *Log) setup() error {
+	files, err := ioutil.ReadDir(l.Dir)
+	if err != nil {
+		return err
+	}
+	var baseOffsets []uint64
+	for _, file := range files {
+		name := file.Name()
+		if strings.Contains(name, snapshotExt+".tmp") {
+			// Left behind by a crash part way through compaction.
+			if err := os.Remove(filepath.Join(l.Dir, name)); err != nil {
+				return err
+			}
+			continue
+		}
+		ext := filepath.Ext(name)
+		offset, err := strconv.ParseUint(strings.TrimSuffix(name, ext), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch ext {
+		case ".log":
+			baseOffsets = append(baseOffsets, offset)
+		case snapshotExt:
+			if offset > l.snapshot {
+				l.snapshot = offset
+			}
+		}
+	}
+	sort.Slice(baseOffsets, func(i, j int) bool { return baseOffsets[i] < baseOffsets[j] })
+
+	for _, baseOffset := range baseOffsets {
+		if err := l.newSegment(baseOffset); err != nil {
+			return err
+		}
+	}
+	// Appends resume after the latest snapshot even if a crash tore records it covers off the active
+	// segment, as appends at offsets below it would be skipped by replay.
+	if l.segments == nil || l.active.nextOffset < l.snapshot {
+		if err := l.newSegment(l.snapshot); err != nil {
+			return err
+		}
+		return syncDir(l.Dir)
+	}
+	return nil
+}
+
+func (l *Log) newSegment(baseOffset uint64) error {
+	s, err := newSegment(l.Dir, baseOffset)
+	if err != nil {
+		return err
+	}
+	l.segments = append(l.segments, s)
+	l.active = s
+	return nil
+}
+
+// Append writes the record to the end of the log, fsyncing according to the sync policy, and
+// returns its offset.
+func (l *Log) Append(record []byte) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	offset, err := l.active.append(record)
+	if err != nil {
+		return 0, err
+	}
+	l.dirty = true
+
+	if l.active.isMaxed(l.Config.MaxSegmentBytes) {
+		// The sealed segment is always synced so that only the active segment can be torn.
+		if err := l.sync(); err != nil {
+			return 0, err
+		}
+		if err := l.newSegment(offset + 1); err != nil {
+			return 0, err
+		}
+		if err := syncDir(l.Dir); err != nil {
+			return 0, err
+		}
+	}
+
+	if l.Config.Sync == SyncAlways {
+		return offset, l.sync()
+	}
+	return offset, nil
+}
+
+// Read returns the record at the given offset.
+func (l *Log) Read(offset uint64) ([]byte, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, s := range l.segments {
+		if s.baseOffset <= offset && offset < s.nextOffset {
+			return s.read(offset)
+		}
+	}
+	return nil, fmt.Errorf("offset out of range: %d", offset)
+}
+
+// Replay calls fn with every record in the latest snapshot followed by every record appended
+// after it, in order.
+func (l *Log) Replay(fn func(record []byte) error) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.snapshot > 0 {
+		if err := readSnapshot(snapshotPath(l.Dir, l.snapshot), fn); err != nil {
+			return err
+		}
+	}
+	for _, s := range l.segments {
+		err := s.replay(l.snapshot, func(_ uint64, record []byte) error {
+			return fn(record)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NextOffset returns the offset the next appended record will be given.
+func (l *Log) NextOffset() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.active.nextOffset
+}
+
+// Compact writes records as a snapshot of the state up to, but not including, offset and then
+// removes the segments and older snapshots it makes redundant.
+func (l *Log) Compact(offset uint64, records [][]byte) error {
+	// The latest snapshot already covers the records up to offset, and one at the same offset would
+	// be written over it.
+	l.mu.RLock()
+	latest := l.snapshot
+	l.mu.RUnlock()
+	if offset <= latest {
+		return nil
+	}
+	// Whatever the sync policy, the records below offset must be durable before the snapshot is, so
+	// that a crash can't leave the log ending below the snapshot.
+	if err := l.Sync(); err != nil {
+		return err
+	}
+	if err := writeSnapshot(l.Dir, offset, records); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if offset <= l.snapshot {
+		// A compaction further along finished while the snapshot was written, leaving it redundant.
+		if offset < l.snapshot {
+			return os.Remove(snapshotPath(l.Dir, offset))
+		}
+		return nil
+	}
+	previous := l.snapshot
+	l.snapshot = offset
+
+	var segments []*segment
+	for _, s := range l.segments {
+		if s != l.active && s.nextOffset <= offset {
+			if err := s.remove(); err != nil {
+				return err
+			}
+			continue
+		}
+		segments = append(segments, s)
+	}
+	l.segments = segments
+
+	if previous > 0 {
+		if err := os.Remove(snapshotPath(l.Dir, previous)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Log) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.sync()
+}
+
+func (l *Log) sync() error {
+	if !l.dirty {
+		return nil
+	}
+	if err := l.active.sync(); err != nil {
+		return err
+	}
+	l.dirty = false
+	return nil
+}
+
+func (l *Log) syncLoop() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(l.Config.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.Sync()
+		case <-l.done:
+			return
+		}
+	}
+}
+
+func (l *Log) Close() error {
+	close(l.done)
+	l.wg.Wait()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closeSegments()
+}
+
+func (l *Log) closeSegments() error {
+	for _, s := range l.segments {
+		if err := s.close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncDir fsyncs a directory so newly created, renamed or removed files within it survive a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,178 @@
+package wal
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogAppendReadReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Small segments so the records span several of them.
+	c := Config{MaxSegmentBytes: 32}
+	l, err := Open(dir, c)
+	require.NoError(t, err)
+	records := []string{"foo", "bar", "baz", "qux", "quux"}
+	for i, record := range records {
+		offset, err := l.Append([]byte(record))
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), offset)
+	}
+	require.True(t, len(l.segments) > 1)
+	require.NoError(t, l.Close())
+
+	l, err = Open(dir, c)
+	require.NoError(t, err)
+	defer l.Close()
+	require.Equal(t, records, replay(t, l))
+	require.Equal(t, uint64(len(records)), l.NextOffset())
+
+	record, err := l.Read(3)
+	require.NoError(t, err)
+	require.Equal(t, "qux", string(record))
+	_, err = l.Read(5)
+	require.Error(t, err)
+}
+
+func TestLogRecoverTornWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	l, err := Open(dir, Config{Sync: SyncNever})
+	require.NoError(t, err)
+	_, err = l.Append([]byte("foo"))
+	require.NoError(t, err)
+	_, err = l.Append([]byte("bar"))
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	// Simulate a crash part way through writing the second record.
+	path := segmentPath(dir, 0, ".log")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	l, err = Open(dir, Config{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo"}, replay(t, l))
+
+	// Appends after recovery land directly after the last intact record.
+	offset, err := l.Append([]byte("baz"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), offset)
+	require.Equal(t, []string{"foo", "baz"}, replay(t, l))
+	require.NoError(t, l.Close())
+}
+
+func TestLogRecoverMissingIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	l, err := Open(dir, Config{})
+	require.NoError(t, err)
+	for _, record := range []string{"foo", "bar"} {
+		_, err = l.Append([]byte(record))
+		require.NoError(t, err)
+	}
+	require.NoError(t, l.Close())
+
+	require.NoError(t, os.Truncate(segmentPath(dir, 0, ".index"), entWidth+3))
+
+	l, err = Open(dir, Config{})
+	require.NoError(t, err)
+	defer l.Close()
+	record, err := l.Read(1)
+	require.NoError(t, err)
+	require.Equal(t, "bar", string(record))
+}
+
+func TestLogCompact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := Config{MaxSegmentBytes: 32}
+	l, err := Open(dir, c)
+	require.NoError(t, err)
+	for _, record := range []string{"foo", "bar", "baz", "qux"} {
+		_, err = l.Append([]byte(record))
+		require.NoError(t, err)
+	}
+	segments := len(l.segments)
+	require.NoError(t, l.Compact(3, [][]byte{[]byte("snap")}))
+	require.True(t, len(l.segments) < segments)
+	// Compacting again up to the same offset keeps the snapshot.
+	require.NoError(t, l.Compact(3, [][]byte{[]byte("snap")}))
+	require.NoError(t, l.Compact(2, [][]byte{[]byte("stale")}))
+	_, err = l.Append([]byte("quux"))
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	l, err = Open(dir, c)
+	require.NoError(t, err)
+	defer l.Close()
+	require.Equal(t, []string{"snap", "qux", "quux"}, replay(t, l))
+	require.Equal(t, uint64(5), l.NextOffset())
+}
+
+func TestLogRecoverTornWriteBelowSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := Config{Sync: SyncNever}
+	l, err := Open(dir, c)
+	require.NoError(t, err)
+	for _, record := range []string{"foo", "bar", "baz"} {
+		_, err = l.Append([]byte(record))
+		require.NoError(t, err)
+	}
+	require.NoError(t, l.Compact(3, [][]byte{[]byte("snap")}))
+	require.NoError(t, l.Close())
+
+	// Simulate a crash losing records the snapshot already covers.
+	path := segmentPath(dir, 0, ".log")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	l, err = Open(dir, c)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), l.NextOffset())
+	offset, err := l.Append([]byte("qux"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), offset)
+	require.NoError(t, l.Close())
+
+	l, err = Open(dir, c)
+	require.NoError(t, err)
+	defer l.Close()
+	require.Equal(t, []string{"snap", "qux"}, replay(t, l))
+	require.Equal(t, uint64(4), l.NextOffset())
+}
+
+func TestParseSyncPolicy(t *testing.T) {
+	for _, policy := range []SyncPolicy{SyncAlways, SyncInterval, SyncNever} {
+		parsed, err := ParseSyncPolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+	_, err := ParseSyncPolicy("sometimes")
+	require.Error(t, err)
+}
+
+func replay(t *testing.T, l *Log) []string {
+	var records []string
+	require.NoError(t, l.Replay(func(record []byte) error {
+		records = append(records, string(record))
+		return nil
+	}))
+	return records
+}
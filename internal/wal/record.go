@@ -0,0 +1,59 @@
+package wal
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+const (
+	lenWidth    = 4
+	crcWidth    = 4
+	headerWidth = lenWidth + crcWidth
+)
+
+var (
+	enc      = binary.BigEndian
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errCorrupt = errors.New("corrupt record")
+)
+
+// Records are framed as a 4 byte length, a 4 byte CRC-32C of the data and the data itself, both in
+// segment files and snapshots.
+func frame(record []byte) []byte {
+	buf := make([]byte, headerWidth+len(record))
+	enc.PutUint32(buf, uint32(len(record)))
+	enc.PutUint32(buf[lenWidth:], crc32.Checksum(record, crcTable))
+	copy(buf[headerWidth:], record)
+	return buf
+}
+
+// readRecord reads the record framed at pos, which must end at or before size. A record that
+// overruns size or fails its checksum was torn by a crash or is otherwise not to be trusted.
+func readRecord(r io.ReaderAt, pos, size int64) ([]byte, error) {
+	if pos+headerWidth > size {
+		return nil, io.EOF
+	}
+	header := make([]byte, headerWidth)
+	if _, err := r.ReadAt(header, pos); err != nil {
+		return nil, err
+	}
+	n := int64(enc.Uint32(header))
+	if pos+headerWidth+n > size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	record := make([]byte, n)
+	if _, err := r.ReadAt(record, pos+headerWidth); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(record, crcTable) != enc.Uint32(header[lenWidth:]) {
+		return nil, errCorrupt
+	}
+	return record, nil
+}
+
+func isTorn(err error) bool {
+	return err == io.EOF || err == io.ErrUnexpectedEOF || err == errCorrupt
+}
@@ -0,0 +1,188 @@
+package wal
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// A segment is one size-bounded piece of the log: a file of framed records holding offsets
+// [baseOffset, nextOffset), and an index file locating each of those records within it.
+type segment struct {
+	baseOffset uint64
+	nextOffset uint64
+	log        *os.File
+	size       int64
+	index      *index
+}
+
+func newSegment(dir string, baseOffset uint64) (*segment, error) {
+	logFile, err := os.OpenFile(segmentPath(dir, baseOffset, ".log"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	indexFile, err := os.OpenFile(segmentPath(dir, baseOffset, ".index"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		logFile.Close()
+		return nil, err
+	}
+	idx, err := newIndex(indexFile)
+	if err == nil {
+		s := &segment{baseOffset: baseOffset, log: logFile, index: idx}
+		if err = s.recover(); err == nil {
+			return s, nil
+		}
+	}
+	logFile.Close()
+	indexFile.Close()
+	return nil, err
+}
+
+// recover reconciles the log and index files after an unclean shutdown. Index entries pointing at
+// torn records are dropped, intact records the index missed are indexed, and anything after the
+// last intact record is truncated from the log.
+func (s *segment) recover() error {
+	info, err := s.log.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+
+	var pos int64
+	n := s.index.entries()
+	for ; n > 0; n-- {
+		p, err := s.index.read(n - 1)
+		if err != nil {
+			return err
+		}
+		record, err := readRecord(s.log, p, size)
+		if err == nil {
+			pos = p + headerWidth + int64(len(record))
+			break
+		}
+		if !isTorn(err) {
+			return err
+		}
+	}
+	if n < s.index.entries() {
+		if err := s.index.truncate(n); err != nil {
+			return err
+		}
+	}
+
+	for {
+		record, err := readRecord(s.log, pos, size)
+		if isTorn(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.index.write(pos); err != nil {
+			return err
+		}
+		pos += headerWidth + int64(len(record))
+		n++
+	}
+	if pos < size {
+		if err := s.log.Truncate(pos); err != nil {
+			return err
+		}
+	}
+
+	s.size = pos
+	s.nextOffset = s.baseOffset + n
+	return nil
+}
+
+func (s *segment) append(record []byte) (uint64, error) {
+	n, err := s.log.WriteAt(frame(record), s.size)
+	if err != nil {
+		// Drop whatever part of the record made it to disk so the next append starts clean.
+		s.log.Truncate(s.size)
+		return 0, err
+	}
+	if err := s.index.write(s.size); err != nil {
+		s.log.Truncate(s.size)
+		return 0, err
+	}
+	s.size += int64(n)
+	offset := s.nextOffset
+	s.nextOffset++
+	return offset, nil
+}
+
+func (s *segment) read(offset uint64) ([]byte, error) {
+	if offset < s.baseOffset || offset >= s.nextOffset {
+		return nil, fmt.Errorf("offset %d out of range for segment [%d, %d)", offset, s.baseOffset, s.nextOffset)
+	}
+	pos, err := s.index.read(offset - s.baseOffset)
+	if err != nil {
+		return nil, err
+	}
+	return readRecord(s.log, pos, s.size)
+}
+
+// replay calls fn with each record from the given offset onwards.
+func (s *segment) replay(from uint64, fn func(offset uint64, record []byte) error) error {
+	if from < s.baseOffset {
+		from = s.baseOffset
+	}
+	if from >= s.nextOffset {
+		return nil
+	}
+	pos, err := s.index.read(from - s.baseOffset)
+	if err != nil {
+		return err
+	}
+	for offset := from; offset < s.nextOffset; offset++ {
+		record, err := readRecord(s.log, pos, s.size)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(offset, record); err != nil {
+			return err
+		}
+		pos += headerWidth + int64(len(record))
+	}
+	return nil
+}
+
+func (s *segment) isMaxed(maxBytes int64) bool {
+	return s.size >= maxBytes
+}
+
+func (s *segment) sync() error {
+	if err := s.log.Sync(); err != nil {
+		return err
+	}
+	return s.index.sync()
+}
+
+func (s *segment) close() error {
+	if err := s.sync(); err != nil {
+		return err
+	}
+	if err := s.log.Close(); err != nil {
+		return err
+	}
+	return s.index.close()
+}
+
+func (s *segment) remove() error {
+	if err := s.close(); err != nil {
+		return err
+	}
+	if err := os.Remove(s.log.Name()); err != nil {
+		return err
+	}
+	return os.Remove(s.index.file.Name())
+}
+
+func segmentPath(dir string, baseOffset uint64, ext string) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", baseOffset, ext))
+}
@@ -0,0 +1,73 @@
+package wal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const snapshotExt = ".snapshot"
+
+// writeSnapshot atomically writes records as the snapshot of the log's state up to, but not
+// including, offset. The snapshot is written to a temporary file, fsynced and then renamed into
+// place, so a crash leaves either the complete snapshot or none at all.
+func writeSnapshot(dir string, offset uint64, records [][]byte) error {
+	path := snapshotPath(dir, offset)
+	tmp, err := ioutil.TempFile(dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	var pos int64
+	for _, record := range records {
+		n, err := tmp.WriteAt(frame(record), pos)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		pos += int64(n)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// readSnapshot calls fn with each record in the snapshot. Snapshots are only ever renamed into
+// place once complete, so unlike segments any damage is reported rather than truncated.
+func readSnapshot(path string, fn func(record []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	var pos int64
+	for pos < info.Size() {
+		record, err := readRecord(f, pos, info.Size())
+		if err != nil {
+			return fmt.Errorf("snapshot %s: %w", path, err)
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+		pos += headerWidth + int64(len(record))
+	}
+	return nil
+}
+
+func snapshotPath(dir string, offset uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", offset, snapshotExt))
+}